@@ -0,0 +1,265 @@
+package bitset
+
+import (
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/set"
+	"math/bits"
+)
+
+const wordSize = 64
+
+// BitSet keeps its words trimmed so that the last word, if any, is never
+// zero. This keeps IsEmpty, Length and Equals independent of history.
+type BitSet struct {
+	words []uint64
+}
+
+func NewBitSet(values ...uint32) *BitSet {
+	bs := &BitSet{words: make([]uint64, 0)}
+
+	for _, v := range values {
+		bs.Set(v)
+	}
+
+	return bs
+}
+
+func (bs *BitSet) Set(i uint32) {
+	w := int(i / wordSize)
+
+	bs.grow(w + 1)
+
+	bs.words[w] |= 1 << (i % wordSize)
+}
+
+func (bs *BitSet) Clear(i uint32) {
+	w := int(i / wordSize)
+	if w >= len(bs.words) {
+		return
+	}
+
+	bs.words[w] &^= 1 << (i % wordSize)
+
+	bs.trim()
+}
+
+func (bs *BitSet) Test(i uint32) bool {
+	w := int(i / wordSize)
+	if w >= len(bs.words) {
+		return false
+	}
+
+	return bs.words[w]&(1<<(i%wordSize)) != 0
+}
+
+func (bs *BitSet) Flip(i uint32) {
+	w := int(i / wordSize)
+
+	bs.grow(w + 1)
+
+	bs.words[w] ^= 1 << (i % wordSize)
+
+	bs.trim()
+}
+
+func (bs *BitSet) And(o *BitSet) {
+	sz := minOf(len(bs.words), len(o.words))
+
+	for i := 0; i < sz; i++ {
+		bs.words[i] &= o.words[i]
+	}
+
+	bs.words = bs.words[:sz]
+
+	bs.trim()
+}
+
+func (bs *BitSet) Or(o *BitSet) {
+	bs.grow(len(o.words))
+
+	for i, w := range o.words {
+		bs.words[i] |= w
+	}
+}
+
+func (bs *BitSet) Xor(o *BitSet) {
+	bs.grow(len(o.words))
+
+	for i, w := range o.words {
+		bs.words[i] ^= w
+	}
+
+	bs.trim()
+}
+
+func (bs *BitSet) AndNot(o *BitSet) {
+	sz := minOf(len(bs.words), len(o.words))
+
+	for i := 0; i < sz; i++ {
+		bs.words[i] &^= o.words[i]
+	}
+
+	bs.trim()
+}
+
+func (bs *BitSet) Cardinality() int64 {
+	var res int64
+
+	for _, w := range bs.words {
+		res += int64(bits.OnesCount64(w))
+	}
+
+	return res
+}
+
+// Length returns the index of the highest set bit plus one.
+func (bs *BitSet) Length() int64 {
+	sz := len(bs.words)
+	if sz == 0 {
+		return internal.Zero
+	}
+
+	return int64((sz-1)*wordSize + bits.Len64(bs.words[sz-1]))
+}
+
+// NextSetBit returns the index of the first set bit at or after from, or -1.
+func (bs *BitSet) NextSetBit(from uint32) int64 {
+	w := int(from / wordSize)
+	if w >= len(bs.words) {
+		return internal.InvalidIndex
+	}
+
+	word := bs.words[w] & (^uint64(0) << (from % wordSize))
+
+	for {
+		if word != 0 {
+			return int64(w*wordSize + bits.TrailingZeros64(word))
+		}
+
+		w++
+		if w >= len(bs.words) {
+			return internal.InvalidIndex
+		}
+
+		word = bs.words[w]
+	}
+}
+
+// NextClearBit returns the index of the first clear bit at or after from.
+func (bs *BitSet) NextClearBit(from uint32) int64 {
+	w := int(from / wordSize)
+	if w >= len(bs.words) {
+		return int64(from)
+	}
+
+	word := ^bs.words[w] & (^uint64(0) << (from % wordSize))
+
+	for {
+		if word != 0 {
+			return int64(w*wordSize + bits.TrailingZeros64(word))
+		}
+
+		w++
+		if w >= len(bs.words) {
+			return int64(w * wordSize)
+		}
+
+		word = ^bs.words[w]
+	}
+}
+
+func (bs *BitSet) IsEmpty() bool {
+	return len(bs.words) == 0
+}
+
+func (bs *BitSet) Reset() {
+	bs.words = make([]uint64, 0)
+}
+
+func (bs *BitSet) Clone() *BitSet {
+	words := make([]uint64, len(bs.words))
+	copy(words, bs.words)
+
+	return &BitSet{words: words}
+}
+
+func (bs *BitSet) empty() *BitSet {
+	return NewBitSet()
+}
+
+func (bs *BitSet) Equals(o *BitSet) bool {
+	if len(bs.words) != len(o.words) {
+		return false
+	}
+
+	for i, w := range bs.words {
+		if w != o.words[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (bs *BitSet) Iterator() iterator.Iterator[uint32] {
+	return &bitIterator{next: bs.NextSetBit(0), nextSetBit: bs.NextSetBit}
+}
+
+func (bs *BitSet) AsSet() set.Set[uint32] {
+	return newSetView[*BitSet](bs)
+}
+
+func (bs *BitSet) grow(sz int) {
+	if sz <= len(bs.words) {
+		return
+	}
+
+	words := make([]uint64, sz)
+	copy(words, bs.words)
+
+	bs.words = words
+}
+
+func (bs *BitSet) trim() {
+	sz := len(bs.words)
+
+	for sz > 0 && bs.words[sz-1] == 0 {
+		sz--
+	}
+
+	bs.words = bs.words[:sz]
+}
+
+type bitIterator struct {
+	next       int64
+	nextSetBit func(from uint32) int64
+}
+
+func (bi *bitIterator) HasNext() bool {
+	return bi.next != internal.InvalidIndex
+}
+
+func (bi *bitIterator) Next() (uint32, error) {
+	if bi.next == internal.InvalidIndex {
+		return 0, emptyIteratorError("bitIterator.Next")
+	}
+
+	res := uint32(bi.next)
+
+	if res == ^uint32(0) {
+		bi.next = internal.InvalidIndex
+	} else {
+		bi.next = bi.nextSetBit(res + 1)
+	}
+
+	return res, nil
+}
+
+func minOf(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,137 @@
+package bitset
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func toSlice(it iterator.Iterator[uint32]) []uint32 {
+	res := make([]uint32, 0)
+
+	for it.HasNext() {
+		v, _ := it.Next()
+		res = append(res, v)
+	}
+
+	return res
+}
+
+func TestBitSetSetClearTestFlip(t *testing.T) {
+	bs := NewBitSet(1, 64, 200)
+
+	assert.True(t, bs.Test(1))
+	assert.True(t, bs.Test(64))
+	assert.True(t, bs.Test(200))
+	assert.False(t, bs.Test(2))
+	assert.False(t, bs.Test(100000))
+
+	bs.Clear(64)
+	bs.Clear(100000)
+	assert.False(t, bs.Test(64))
+
+	bs.Flip(3)
+	bs.Flip(200)
+	assert.True(t, bs.Test(3))
+	assert.False(t, bs.Test(200))
+
+	assert.Equal(t, []uint32{1, 3}, toSlice(bs.Iterator()))
+	assert.Equal(t, int64(4), bs.Length())
+	assert.Equal(t, int64(2), bs.Cardinality())
+
+	bs.Reset()
+	assert.True(t, bs.IsEmpty())
+	assert.Equal(t, int64(0), bs.Length())
+}
+
+func TestBitSetBulkOperations(t *testing.T) {
+	testCases := map[string]struct {
+		actualResult   func() *BitSet
+		expectedResult []uint32
+	}{
+		"and should keep common bits": {
+			actualResult: func() *BitSet {
+				bs := NewBitSet(1, 2, 3, 130)
+				bs.And(NewBitSet(2, 3, 4))
+				return bs
+			},
+			expectedResult: []uint32{2, 3},
+		},
+		"or should keep all bits": {
+			actualResult: func() *BitSet {
+				bs := NewBitSet(1, 2)
+				bs.Or(NewBitSet(2, 300))
+				return bs
+			},
+			expectedResult: []uint32{1, 2, 300},
+		},
+		"xor should keep bits set in exactly one": {
+			actualResult: func() *BitSet {
+				bs := NewBitSet(1, 2, 300)
+				bs.Xor(NewBitSet(2, 3, 300))
+				return bs
+			},
+			expectedResult: []uint32{1, 3},
+		},
+		"and not should remove bits of other": {
+			actualResult: func() *BitSet {
+				bs := NewBitSet(1, 2, 300)
+				bs.AndNot(NewBitSet(2, 300, 400))
+				return bs
+			},
+			expectedResult: []uint32{1},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedResult, toSlice(testCase.actualResult().Iterator()))
+		})
+	}
+}
+
+func TestBitSetNextBits(t *testing.T) {
+	bs := NewBitSet(0, 1, 2, 63, 64, 130)
+
+	assert.Equal(t, int64(0), bs.NextSetBit(0))
+	assert.Equal(t, int64(63), bs.NextSetBit(3))
+	assert.Equal(t, int64(130), bs.NextSetBit(65))
+	assert.Equal(t, int64(internal.InvalidIndex), bs.NextSetBit(131))
+	assert.Equal(t, int64(internal.InvalidIndex), bs.NextSetBit(5000))
+
+	assert.Equal(t, int64(3), bs.NextClearBit(0))
+	assert.Equal(t, int64(65), bs.NextClearBit(63))
+	assert.Equal(t, int64(131), bs.NextClearBit(130))
+	assert.Equal(t, int64(5000), bs.NextClearBit(5000))
+
+	it := NewBitSet().Iterator()
+	assert.False(t, it.HasNext())
+
+	_, err := it.Next()
+	internal.AssertErrorEquals(t, errors.New("iterator is empty"), err)
+}
+
+func TestBitSetCloneAndEquals(t *testing.T) {
+	bs := NewBitSet(5, 500)
+	cl := bs.Clone()
+
+	assert.True(t, bs.Equals(cl))
+
+	cl.Set(6)
+	assert.False(t, bs.Equals(cl))
+
+	cl.Clear(6)
+	cl.Set(1000)
+	cl.Clear(1000)
+	assert.True(t, bs.Equals(cl))
+}
+
+func TestBitSetMaxValue(t *testing.T) {
+	bs := NewBitSet()
+	bs.Set(1 << 20)
+	bs.Set(1<<20 + 1)
+
+	assert.Equal(t, []uint32{1 << 20, 1<<20 + 1}, toSlice(bs.Iterator()))
+}
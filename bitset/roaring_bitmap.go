@@ -0,0 +1,239 @@
+package bitset
+
+import (
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/set"
+	"sort"
+)
+
+// RoaringBitmap is a compressed bitmap for sparse 32 bit values, values are
+// partitioned by their high 16 bits and each partition is stored in a container.
+type RoaringBitmap struct {
+	keys       []uint16
+	containers []container
+}
+
+func NewRoaringBitmap(values ...uint32) *RoaringBitmap {
+	rb := &RoaringBitmap{
+		keys:       make([]uint16, 0),
+		containers: make([]container, 0),
+	}
+
+	for _, v := range values {
+		rb.Set(v)
+	}
+
+	return rb
+}
+
+func (rb *RoaringBitmap) Set(i uint32) {
+	hb, lb := split(i)
+
+	idx, found := rb.search(hb)
+	if !found {
+		rb.insertAt(idx, hb, &arrayContainer{values: make([]uint16, 0)})
+	}
+
+	rb.containers[idx] = rb.containers[idx].add(lb)
+}
+
+func (rb *RoaringBitmap) Clear(i uint32) {
+	hb, lb := split(i)
+
+	idx, found := rb.search(hb)
+	if !found {
+		return
+	}
+
+	rb.containers[idx] = rb.containers[idx].remove(lb)
+
+	if rb.containers[idx].cardinality() == 0 {
+		rb.removeAt(idx)
+	}
+}
+
+func (rb *RoaringBitmap) Test(i uint32) bool {
+	hb, lb := split(i)
+
+	idx, found := rb.search(hb)
+	if !found {
+		return false
+	}
+
+	return rb.containers[idx].contains(lb)
+}
+
+func (rb *RoaringBitmap) Flip(i uint32) {
+	if rb.Test(i) {
+		rb.Clear(i)
+	} else {
+		rb.Set(i)
+	}
+}
+
+func (rb *RoaringBitmap) And(o *RoaringBitmap) {
+	rb.merge(o, func(inA, inB bool) bool { return inA && inB }, func(x, y uint64) uint64 { return x & y })
+}
+
+func (rb *RoaringBitmap) Or(o *RoaringBitmap) {
+	rb.merge(o, func(inA, inB bool) bool { return inA || inB }, func(x, y uint64) uint64 { return x | y })
+}
+
+func (rb *RoaringBitmap) Xor(o *RoaringBitmap) {
+	rb.merge(o, func(inA, inB bool) bool { return inA != inB }, func(x, y uint64) uint64 { return x ^ y })
+}
+
+func (rb *RoaringBitmap) AndNot(o *RoaringBitmap) {
+	rb.merge(o, func(inA, inB bool) bool { return inA && !inB }, func(x, y uint64) uint64 { return x &^ y })
+}
+
+func (rb *RoaringBitmap) Cardinality() int64 {
+	var res int64
+
+	for _, c := range rb.containers {
+		res += int64(c.cardinality())
+	}
+
+	return res
+}
+
+// NextSetBit returns the first value at or after from, or -1.
+func (rb *RoaringBitmap) NextSetBit(from uint32) int64 {
+	hb, lb := split(from)
+
+	idx, found := rb.search(hb)
+	if found {
+		if v, ok := rb.containers[idx].nextSet(lb); ok {
+			return int64(join(hb, v))
+		}
+
+		idx++
+	}
+
+	if idx < len(rb.keys) {
+		v, _ := rb.containers[idx].nextSet(0)
+		return int64(join(rb.keys[idx], v))
+	}
+
+	return internal.InvalidIndex
+}
+
+func (rb *RoaringBitmap) IsEmpty() bool {
+	return len(rb.keys) == 0
+}
+
+func (rb *RoaringBitmap) Reset() {
+	rb.keys = make([]uint16, 0)
+	rb.containers = make([]container, 0)
+}
+
+func (rb *RoaringBitmap) Clone() *RoaringBitmap {
+	res := &RoaringBitmap{
+		keys:       make([]uint16, len(rb.keys)),
+		containers: make([]container, len(rb.containers)),
+	}
+
+	copy(res.keys, rb.keys)
+
+	for i, c := range rb.containers {
+		res.containers[i] = c.clone()
+	}
+
+	return res
+}
+
+func (rb *RoaringBitmap) empty() *RoaringBitmap {
+	return NewRoaringBitmap()
+}
+
+func (rb *RoaringBitmap) Equals(o *RoaringBitmap) bool {
+	if len(rb.keys) != len(o.keys) || rb.Cardinality() != o.Cardinality() {
+		return false
+	}
+
+	a := rb.Iterator()
+	b := o.Iterator()
+
+	for a.HasNext() && b.HasNext() {
+		x, _ := a.Next()
+		y, _ := b.Next()
+
+		if x != y {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (rb *RoaringBitmap) Iterator() iterator.Iterator[uint32] {
+	return &bitIterator{next: rb.NextSetBit(0), nextSetBit: rb.NextSetBit}
+}
+
+func (rb *RoaringBitmap) AsSet() set.Set[uint32] {
+	return newSetView[*RoaringBitmap](rb)
+}
+
+func (rb *RoaringBitmap) merge(o *RoaringBitmap, keep func(inA, inB bool) bool, op func(x, y uint64) uint64) {
+	empty := &arrayContainer{values: make([]uint16, 0)}
+
+	keys := make([]uint16, 0)
+	containers := make([]container, 0)
+
+	add := func(key uint16, a, b container) {
+		c := combine(a, b, keep, op)
+		if c.cardinality() > 0 {
+			keys = append(keys, key)
+			containers = append(containers, c)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(rb.keys) || j < len(o.keys) {
+		switch {
+		case j >= len(o.keys) || (i < len(rb.keys) && rb.keys[i] < o.keys[j]):
+			add(rb.keys[i], rb.containers[i], empty)
+			i++
+		case i >= len(rb.keys) || o.keys[j] < rb.keys[i]:
+			add(o.keys[j], empty, o.containers[j])
+			j++
+		default:
+			add(rb.keys[i], rb.containers[i], o.containers[j])
+			i++
+			j++
+		}
+	}
+
+	rb.keys = keys
+	rb.containers = containers
+}
+
+func (rb *RoaringBitmap) search(hb uint16) (int, bool) {
+	idx := sort.Search(len(rb.keys), func(i int) bool { return rb.keys[i] >= hb })
+
+	return idx, idx < len(rb.keys) && rb.keys[idx] == hb
+}
+
+func (rb *RoaringBitmap) insertAt(idx int, hb uint16, c container) {
+	rb.keys = append(rb.keys, 0)
+	copy(rb.keys[idx+1:], rb.keys[idx:])
+	rb.keys[idx] = hb
+
+	rb.containers = append(rb.containers, nil)
+	copy(rb.containers[idx+1:], rb.containers[idx:])
+	rb.containers[idx] = c
+}
+
+func (rb *RoaringBitmap) removeAt(idx int) {
+	rb.keys = append(rb.keys[:idx], rb.keys[idx+1:]...)
+	rb.containers = append(rb.containers[:idx], rb.containers[idx+1:]...)
+}
+
+func split(i uint32) (uint16, uint16) {
+	return uint16(i >> 16), uint16(i)
+}
+
+func join(hb, lb uint16) uint32 {
+	return uint32(hb)<<16 | uint32(lb)
+}
@@ -0,0 +1,108 @@
+package bitset
+
+import (
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestRoaringBitmapSetClearTest(t *testing.T) {
+	rb := NewRoaringBitmap(1, 70000, 1<<31, ^uint32(0))
+
+	assert.True(t, rb.Test(1))
+	assert.True(t, rb.Test(70000))
+	assert.True(t, rb.Test(1<<31))
+	assert.True(t, rb.Test(^uint32(0)))
+	assert.False(t, rb.Test(2))
+
+	rb.Clear(70000)
+	rb.Clear(5)
+	rb.Flip(7)
+	rb.Flip(1)
+
+	assert.Equal(t, []uint32{7, 1 << 31, ^uint32(0)}, toSlice(rb.Iterator()))
+	assert.Equal(t, int64(3), rb.Cardinality())
+	assert.Equal(t, 3, len(rb.keys))
+
+	rb.Reset()
+	assert.True(t, rb.IsEmpty())
+}
+
+func TestRoaringBitmapContainerConversion(t *testing.T) {
+	rb := NewRoaringBitmap()
+
+	for i := uint32(0); i < 2*arrayContainerMaxSize; i += 2 {
+		rb.Set(i)
+	}
+
+	assert.IsType(t, &arrayContainer{}, rb.containers[0])
+
+	rb.Set(1)
+	assert.IsType(t, &bitmapContainer{}, rb.containers[0])
+	assert.Equal(t, int64(arrayContainerMaxSize+1), rb.Cardinality())
+
+	rb.Clear(1)
+	assert.IsType(t, &arrayContainer{}, rb.containers[0])
+	assert.Equal(t, int64(arrayContainerMaxSize), rb.Cardinality())
+}
+
+func TestRoaringBitmapNextSetBit(t *testing.T) {
+	rb := NewRoaringBitmap(3, 65535, 65536, 1<<20)
+
+	assert.Equal(t, int64(3), rb.NextSetBit(0))
+	assert.Equal(t, int64(65535), rb.NextSetBit(4))
+	assert.Equal(t, int64(65536), rb.NextSetBit(65536))
+	assert.Equal(t, int64(1<<20), rb.NextSetBit(65537))
+	assert.Equal(t, int64(internal.InvalidIndex), rb.NextSetBit(1<<20+1))
+}
+
+func TestRoaringBitmapMatchesBitSet(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	generate := func(n int, max int32) []uint32 {
+		res := make([]uint32, n)
+		for i := range res {
+			res[i] = uint32(r.Int31n(max))
+		}
+		return res
+	}
+
+	operations := map[string]func(a, b *RoaringBitmap, x, y *BitSet){
+		"and":     func(a, b *RoaringBitmap, x, y *BitSet) { a.And(b); x.And(y) },
+		"or":      func(a, b *RoaringBitmap, x, y *BitSet) { a.Or(b); x.Or(y) },
+		"xor":     func(a, b *RoaringBitmap, x, y *BitSet) { a.Xor(b); x.Xor(y) },
+		"and not": func(a, b *RoaringBitmap, x, y *BitSet) { a.AndNot(b); x.AndNot(y) },
+	}
+
+	for name, op := range operations {
+		t.Run(name, func(t *testing.T) {
+			for _, sz := range []int{10, 3000, 9000} {
+				av := generate(sz, 200000)
+				bv := generate(sz, 200000)
+
+				a, b := NewRoaringBitmap(av...), NewRoaringBitmap(bv...)
+				x, y := NewBitSet(av...), NewBitSet(bv...)
+
+				op(a, b, x, y)
+
+				expected := toSlice(x.Iterator())
+				assert.True(t, sort.SliceIsSorted(expected, func(i, j int) bool { return expected[i] < expected[j] }))
+				assert.Equal(t, expected, toSlice(a.Iterator()))
+				assert.Equal(t, x.Cardinality(), a.Cardinality())
+			}
+		})
+	}
+}
+
+func TestRoaringBitmapCloneAndEquals(t *testing.T) {
+	rb := NewRoaringBitmap(1, 2, 100000)
+	cl := rb.Clone()
+
+	assert.True(t, rb.Equals(cl))
+
+	cl.Set(3)
+	assert.False(t, rb.Equals(cl))
+	assert.False(t, rb.Test(3))
+}
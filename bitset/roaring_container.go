@@ -0,0 +1,227 @@
+package bitset
+
+import (
+	"math/bits"
+	"sort"
+)
+
+const (
+	arrayContainerMaxSize = 4096
+	bitmapContainerWords  = 1 << 16 / wordSize
+)
+
+// container holds the low 16 bits of the values sharing the same high 16 bits,
+// sparse chunks are kept as a sorted array and dense ones as a bitmap.
+type container interface {
+	add(x uint16) container
+	remove(x uint16) container
+	contains(x uint16) bool
+	cardinality() int
+	nextSet(from uint16) (uint16, bool)
+	clone() container
+	toBitmap() *bitmapContainer
+}
+
+type arrayContainer struct {
+	values []uint16
+}
+
+func (ac *arrayContainer) add(x uint16) container {
+	i := ac.indexOf(x)
+	if i < len(ac.values) && ac.values[i] == x {
+		return ac
+	}
+
+	if len(ac.values) >= arrayContainerMaxSize {
+		return ac.toBitmap().add(x)
+	}
+
+	ac.values = append(ac.values, 0)
+	copy(ac.values[i+1:], ac.values[i:])
+	ac.values[i] = x
+
+	return ac
+}
+
+func (ac *arrayContainer) remove(x uint16) container {
+	i := ac.indexOf(x)
+	if i < len(ac.values) && ac.values[i] == x {
+		ac.values = append(ac.values[:i], ac.values[i+1:]...)
+	}
+
+	return ac
+}
+
+func (ac *arrayContainer) contains(x uint16) bool {
+	i := ac.indexOf(x)
+	return i < len(ac.values) && ac.values[i] == x
+}
+
+func (ac *arrayContainer) cardinality() int {
+	return len(ac.values)
+}
+
+func (ac *arrayContainer) nextSet(from uint16) (uint16, bool) {
+	i := ac.indexOf(from)
+	if i < len(ac.values) {
+		return ac.values[i], true
+	}
+
+	return 0, false
+}
+
+func (ac *arrayContainer) clone() container {
+	values := make([]uint16, len(ac.values))
+	copy(values, ac.values)
+
+	return &arrayContainer{values: values}
+}
+
+func (ac *arrayContainer) toBitmap() *bitmapContainer {
+	bc := &bitmapContainer{}
+
+	for _, v := range ac.values {
+		bc.add(v)
+	}
+
+	return bc
+}
+
+func (ac *arrayContainer) indexOf(x uint16) int {
+	return sort.Search(len(ac.values), func(i int) bool { return ac.values[i] >= x })
+}
+
+type bitmapContainer struct {
+	card  int
+	words [bitmapContainerWords]uint64
+}
+
+func (bc *bitmapContainer) add(x uint16) container {
+	if !bc.contains(x) {
+		bc.words[x/wordSize] |= 1 << (x % wordSize)
+		bc.card++
+	}
+
+	return bc
+}
+
+func (bc *bitmapContainer) remove(x uint16) container {
+	if !bc.contains(x) {
+		return bc
+	}
+
+	bc.words[x/wordSize] &^= 1 << (x % wordSize)
+	bc.card--
+
+	if bc.card <= arrayContainerMaxSize {
+		return bc.toArray()
+	}
+
+	return bc
+}
+
+func (bc *bitmapContainer) contains(x uint16) bool {
+	return bc.words[x/wordSize]&(1<<(x%wordSize)) != 0
+}
+
+func (bc *bitmapContainer) cardinality() int {
+	return bc.card
+}
+
+func (bc *bitmapContainer) nextSet(from uint16) (uint16, bool) {
+	w := int(from / wordSize)
+	word := bc.words[w] & (^uint64(0) << (from % wordSize))
+
+	for {
+		if word != 0 {
+			return uint16(w*wordSize + bits.TrailingZeros64(word)), true
+		}
+
+		w++
+		if w >= bitmapContainerWords {
+			return 0, false
+		}
+
+		word = bc.words[w]
+	}
+}
+
+func (bc *bitmapContainer) clone() container {
+	res := *bc
+	return &res
+}
+
+func (bc *bitmapContainer) toBitmap() *bitmapContainer {
+	return bc
+}
+
+func (bc *bitmapContainer) toArray() *arrayContainer {
+	values := make([]uint16, 0, bc.card)
+
+	for w, word := range bc.words {
+		for word != 0 {
+			values = append(values, uint16(w*wordSize+bits.TrailingZeros64(word)))
+			word &= word - 1
+		}
+	}
+
+	return &arrayContainer{values: values}
+}
+
+// combine applies a bitwise operation to two containers, the inputs are never mutated.
+func combine(a, b container, keep func(inA, inB bool) bool, op func(x, y uint64) uint64) container {
+	aa, aok := a.(*arrayContainer)
+	ba, bok := b.(*arrayContainer)
+
+	if aok && bok {
+		res := &arrayContainer{values: mergeArrays(aa.values, ba.values, keep)}
+		if res.cardinality() > arrayContainerMaxSize {
+			return res.toBitmap()
+		}
+
+		return res
+	}
+
+	ab := a.toBitmap()
+	bb := b.toBitmap()
+
+	res := &bitmapContainer{}
+	for i := 0; i < bitmapContainerWords; i++ {
+		res.words[i] = op(ab.words[i], bb.words[i])
+		res.card += bits.OnesCount64(res.words[i])
+	}
+
+	if res.card <= arrayContainerMaxSize {
+		return res.toArray()
+	}
+
+	return res
+}
+
+func mergeArrays(a, b []uint16, keep func(inA, inB bool) bool) []uint16 {
+	res := make([]uint16, 0)
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case j >= len(b) || (i < len(a) && a[i] < b[j]):
+			if keep(true, false) {
+				res = append(res, a[i])
+			}
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			if keep(false, true) {
+				res = append(res, b[j])
+			}
+			j++
+		default:
+			if keep(true, true) {
+				res = append(res, a[i])
+			}
+			i++
+			j++
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,152 @@
+package bitset
+
+import (
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	"github.com/nsnikhil/go-datastructures/set"
+)
+
+type bitmap[B any] interface {
+	Set(i uint32)
+	Clear(i uint32)
+	Test(i uint32) bool
+	And(o B)
+	Or(o B)
+	Cardinality() int64
+	IsEmpty() bool
+	Reset()
+	Clone() B
+	empty() B
+	Iterator() iterator.Iterator[uint32]
+}
+
+// setView adapts a bitmap to set.Set[uint32], the bitmap methods cannot be
+// exposed directly as Clear(i) clashes with Set.Clear().
+type setView[B bitmap[B]] struct {
+	b B
+}
+
+func newSetView[B bitmap[B]](b B) *setView[B] {
+	return &setView[B]{b: b}
+}
+
+func (sv *setView[B]) Add(e uint32) {
+	sv.b.Set(e)
+}
+
+func (sv *setView[B]) AddAll(e ...uint32) {
+	for _, k := range e {
+		sv.b.Set(k)
+	}
+}
+
+func (sv *setView[B]) Clear() {
+	sv.b.Reset()
+}
+
+func (sv *setView[B]) Contains(e uint32) bool {
+	return sv.b.Test(e)
+}
+
+func (sv *setView[B]) ContainsAll(e ...uint32) bool {
+	for _, k := range e {
+		if !sv.b.Test(k) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (sv *setView[B]) Copy() set.Set[uint32] {
+	return newSetView[B](sv.b.Clone())
+}
+
+func (sv *setView[B]) IsEmpty() bool {
+	return sv.b.IsEmpty()
+}
+
+func (sv *setView[B]) Size() int64 {
+	return sv.b.Cardinality()
+}
+
+func (sv *setView[B]) Remove(e uint32) error {
+	return sv.remove(false, e)
+}
+
+func (sv *setView[B]) RemoveAll(e ...uint32) error {
+	return sv.remove(true, e...)
+}
+
+func (sv *setView[B]) RetainAll(e ...uint32) error {
+	if sv.IsEmpty() {
+		return emptySetError("setView.RetainAll")
+	}
+
+	rs := sv.b.empty()
+
+	for _, k := range e {
+		rs.Set(k)
+	}
+
+	sv.b.And(rs)
+
+	return nil
+}
+
+func (sv *setView[B]) Iterator() iterator.Iterator[uint32] {
+	return sv.b.Iterator()
+}
+
+func (sv *setView[B]) Union(s set.Set[uint32]) (set.Set[uint32], error) {
+	res := sv.b.Clone()
+	res.Or(sv.toBitmap(s))
+
+	return newSetView[B](res), nil
+}
+
+func (sv *setView[B]) Intersection(s set.Set[uint32]) (set.Set[uint32], error) {
+	res := sv.b.Clone()
+	res.And(sv.toBitmap(s))
+
+	return newSetView[B](res), nil
+}
+
+func (sv *setView[B]) toBitmap(s set.Set[uint32]) B {
+	if o, ok := s.(*setView[B]); ok {
+		return o.b
+	}
+
+	res := sv.b.empty()
+
+	it := s.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res.Set(e)
+	}
+
+	return res
+}
+
+func (sv *setView[B]) remove(ignore bool, e ...uint32) error {
+	if sv.IsEmpty() {
+		return emptySetError("setView.remove")
+	}
+
+	if len(e) == 0 {
+		return emptyArgsListError("setView.remove")
+	}
+
+	for _, k := range e {
+		if !sv.b.Test(k) {
+			if ignore {
+				continue
+			}
+
+			return elementNotFoundError(k, "setView.remove")
+		}
+
+		sv.b.Clear(k)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package bitset
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/set"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSetView(t *testing.T) {
+	views := map[string]func(values ...uint32) set.Set[uint32]{
+		"bit set":        func(values ...uint32) set.Set[uint32] { return NewBitSet(values...).AsSet() },
+		"roaring bitmap": func(values ...uint32) set.Set[uint32] { return NewRoaringBitmap(values...).AsSet() },
+	}
+
+	for name, newView := range views {
+		t.Run(name, func(t *testing.T) {
+			s := newView(4, 1, 9)
+
+			s.Add(2)
+			s.AddAll(7, 1)
+
+			assert.Equal(t, int64(5), s.Size())
+			assert.True(t, s.ContainsAll(1, 2, 4, 7, 9))
+			assert.Equal(t, []uint32{1, 2, 4, 7, 9}, toSlice(s.Iterator()))
+
+			require.NoError(t, s.Remove(4))
+			internal.AssertErrorEquals(t, errors.New("element 4 not found in the set"), s.Remove(4))
+			require.NoError(t, s.RemoveAll(4, 7))
+			require.NoError(t, s.RetainAll(1, 2, 3))
+			assert.Equal(t, []uint32{1, 2}, toSlice(s.Iterator()))
+
+			u, err := s.Union(newView(3, 1))
+			require.NoError(t, err)
+			assert.Equal(t, []uint32{1, 2, 3}, toSlice(u.Iterator()))
+
+			i, err := s.Intersection(set.NewHashSet[uint32](2, 5))
+			require.NoError(t, err)
+			assert.Equal(t, []uint32{2}, toSlice(i.Iterator()))
+
+			assert.True(t, set.Equals[uint32](s, set.NewHashSet[uint32](1, 2)))
+
+			assert.Equal(t, []uint32{2}, toSlice(set.Difference[uint32](s, set.NewHashSet[uint32](1, 5)).Iterator()))
+
+			cp := s.Copy()
+			s.Clear()
+			assert.True(t, s.IsEmpty())
+			assert.Equal(t, int64(2), cp.Size())
+
+			internal.AssertErrorEquals(t, errors.New("set is empty"), s.Remove(1))
+			internal.AssertErrorEquals(t, errors.New("argument list is empty"), cp.RemoveAll())
+		})
+	}
+}
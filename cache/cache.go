@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"github.com/nsnikhil/go-datastructures/list"
+	"time"
+)
+
+type Cache[K comparable, V comparable] interface {
+	Put(key K, value V)
+
+	PutWithTTL(key K, value V, ttl time.Duration)
+
+	Get(key K) (V, error)
+
+	Peek(key K) (V, error)
+
+	Remove(key K) (V, error)
+
+	ContainsKey(key K) bool
+
+	Keys() list.List[K]
+
+	Purge() int64
+
+	Size() int64
+
+	Capacity() int64
+
+	IsEmpty() bool
+
+	Clear()
+
+	Stats() Stats
+}
@@ -0,0 +1,22 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/nsnikhil/erx"
+)
+
+var keyNotFoundError = func(key interface{}, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("keyNotFoundError"),
+		operation,
+		fmt.Errorf("key %v not found in the cache", key),
+	)
+}
+
+var invalidCapacityError = func(capacity int64, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("invalidCapacityError"),
+		operation,
+		fmt.Errorf("invalid capacity %d", capacity),
+	)
+}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"github.com/nsnikhil/go-datastructures/internal"
+	"time"
+)
+
+type entry[K comparable, V comparable] struct {
+	key       K
+	value     V
+	frequency int64
+	expiresAt time.Time
+
+	prev *entry[K, V]
+	next *entry[K, V]
+}
+
+func (e *entry[K, V]) isExpired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+func newEntry[K comparable, V comparable](key K, value V, expiresAt time.Time) *entry[K, V] {
+	return &entry[K, V]{
+		key:       key,
+		value:     value,
+		frequency: 1,
+		expiresAt: expiresAt,
+	}
+}
+
+// entryList is an intrusive doubly linked list, unlike list.LinkedList it
+// allows unlinking an entry in O(1) when the entry is already known.
+type entryList[K comparable, V comparable] struct {
+	size  int64
+	first *entry[K, V]
+	last  *entry[K, V]
+}
+
+func (el *entryList[K, V]) addFirst(e *entry[K, V]) {
+	e.prev = nil
+	e.next = el.first
+
+	if el.first != nil {
+		el.first.prev = e
+	}
+
+	el.first = e
+
+	if el.last == nil {
+		el.last = e
+	}
+
+	el.size++
+}
+
+func (el *entryList[K, V]) remove(e *entry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		el.first = e.next
+	}
+
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		el.last = e.prev
+	}
+
+	e.prev = nil
+	e.next = nil
+
+	el.size--
+}
+
+func (el *entryList[K, V]) moveToFirst(e *entry[K, V]) {
+	if el.first == e {
+		return
+	}
+
+	el.remove(e)
+	el.addFirst(e)
+}
+
+func (el *entryList[K, V]) isEmpty() bool {
+	return el.size == internal.Zero
+}
+
+func newEntryList[K comparable, V comparable]() *entryList[K, V] {
+	return &entryList[K, V]{size: internal.Zero}
+}
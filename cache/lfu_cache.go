@@ -0,0 +1,257 @@
+package cache
+
+import (
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+	"time"
+)
+
+type LFUCache[K comparable, V comparable] struct {
+	capacity int64
+
+	*config[K, V]
+	stats Stats
+
+	index gmap.Map[K, *entry[K, V]]
+
+	// entries with the same frequency are kept in recency order so that
+	// ties are broken by evicting the least recently used one.
+	buckets      gmap.Map[int64, *entryList[K, V]]
+	minFrequency int64
+}
+
+func NewLFUCache[K comparable, V comparable](capacity int64, opts ...Option[K, V]) (*LFUCache[K, V], error) {
+	if capacity <= internal.Zero {
+		return nil, invalidCapacityError(capacity, "NewLFUCache")
+	}
+
+	return &LFUCache[K, V]{
+		capacity: capacity,
+		config:   newConfig[K, V](opts...),
+		index:    gmap.NewHashMap[K, *entry[K, V]](),
+		buckets:  gmap.NewHashMap[int64, *entryList[K, V]](),
+	}, nil
+}
+
+func (lc *LFUCache[K, V]) Put(key K, value V) {
+	lc.PutWithTTL(key, value, lc.ttl)
+}
+
+func (lc *LFUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	if e, err := lc.index.Get(key); err == nil {
+		e.value = value
+		e.expiresAt = lc.expiryOf(ttl)
+		lc.touch(e)
+		return
+	}
+
+	if lc.index.Size() >= lc.capacity {
+		lc.evict(lc.victim())
+	}
+
+	e := newEntry[K, V](key, value, lc.expiryOf(ttl))
+
+	lc.index.Put(key, e)
+	lc.bucketOf(e.frequency).addFirst(e)
+	lc.minFrequency = e.frequency
+}
+
+func (lc *LFUCache[K, V]) Get(key K) (V, error) {
+	e, err := lc.live(key, "LFUCache.Get")
+	if err != nil {
+		lc.stats.misses++
+		return internal.ZeroValueOf[V](), err
+	}
+
+	lc.stats.hits++
+	lc.touch(e)
+
+	return e.value, nil
+}
+
+func (lc *LFUCache[K, V]) Peek(key K) (V, error) {
+	e, err := lc.live(key, "LFUCache.Peek")
+	if err != nil {
+		return internal.ZeroValueOf[V](), err
+	}
+
+	return e.value, nil
+}
+
+func (lc *LFUCache[K, V]) Remove(key K) (V, error) {
+	e, err := lc.live(key, "LFUCache.Remove")
+	if err != nil {
+		return internal.ZeroValueOf[V](), err
+	}
+
+	lc.unlink(e)
+
+	return e.value, nil
+}
+
+func (lc *LFUCache[K, V]) ContainsKey(key K) bool {
+	_, err := lc.live(key, "LFUCache.ContainsKey")
+	return err == nil
+}
+
+func (lc *LFUCache[K, V]) Frequency(key K) (int64, error) {
+	e, err := lc.live(key, "LFUCache.Frequency")
+	if err != nil {
+		return internal.Zero, err
+	}
+
+	return e.frequency, nil
+}
+
+func (lc *LFUCache[K, V]) Keys() list.List[K] {
+	res := list.NewArrayList[K]()
+
+	now := lc.clock.Get()
+
+	it := lc.index.Iterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		if !p.Second().isExpired(now) {
+			res.Add(p.First())
+		}
+	}
+
+	return res
+}
+
+func (lc *LFUCache[K, V]) Purge() int64 {
+	now := lc.clock.Get()
+
+	var expired []*entry[K, V]
+
+	it := lc.index.Iterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		if p.Second().isExpired(now) {
+			expired = append(expired, p.Second())
+		}
+	}
+
+	for _, e := range expired {
+		lc.expire(e)
+	}
+
+	return int64(len(expired))
+}
+
+func (lc *LFUCache[K, V]) Size() int64 {
+	return lc.index.Size()
+}
+
+func (lc *LFUCache[K, V]) Capacity() int64 {
+	return lc.capacity
+}
+
+func (lc *LFUCache[K, V]) IsEmpty() bool {
+	return lc.index.IsEmpty()
+}
+
+func (lc *LFUCache[K, V]) Clear() {
+	lc.index.Clear()
+	lc.buckets.Clear()
+	lc.minFrequency = internal.Zero
+}
+
+func (lc *LFUCache[K, V]) Stats() Stats {
+	return lc.stats
+}
+
+func (lc *LFUCache[K, V]) live(key K, operation erx.Operation) (*entry[K, V], error) {
+	e, err := lc.index.Get(key)
+	if err != nil {
+		return nil, keyNotFoundError(key, operation)
+	}
+
+	if e.isExpired(lc.clock.Get()) {
+		lc.expire(e)
+		return nil, keyNotFoundError(key, operation)
+	}
+
+	return e, nil
+}
+
+func (lc *LFUCache[K, V]) touch(e *entry[K, V]) {
+	lc.detach(e)
+
+	e.frequency++
+
+	lc.bucketOf(e.frequency).addFirst(e)
+}
+
+// victim scans the buckets only when the min bucket was emptied by a remove or an expiry.
+func (lc *LFUCache[K, V]) victim() *entry[K, V] {
+	if b, err := lc.buckets.Get(lc.minFrequency); err == nil {
+		return b.last
+	}
+
+	it := lc.buckets.Iterator()
+
+	first := true
+	for it.HasNext() {
+		p, _ := it.Next()
+		if first || p.First() < lc.minFrequency {
+			lc.minFrequency = p.First()
+			first = false
+		}
+	}
+
+	b, _ := lc.buckets.Get(lc.minFrequency)
+
+	return b.last
+}
+
+func (lc *LFUCache[K, V]) evict(e *entry[K, V]) {
+	if e.isExpired(lc.clock.Get()) {
+		lc.expire(e)
+		return
+	}
+
+	lc.unlink(e)
+	lc.stats.evictions++
+	lc.notify(e)
+}
+
+func (lc *LFUCache[K, V]) expire(e *entry[K, V]) {
+	lc.unlink(e)
+	lc.stats.expirations++
+	lc.notify(e)
+}
+
+func (lc *LFUCache[K, V]) unlink(e *entry[K, V]) {
+	_, _ = lc.index.Remove(e.key)
+	lc.detach(e)
+}
+
+func (lc *LFUCache[K, V]) detach(e *entry[K, V]) {
+	b, err := lc.buckets.Get(e.frequency)
+	if err != nil {
+		return
+	}
+
+	b.remove(e)
+
+	if b.isEmpty() {
+		_, _ = lc.buckets.Remove(e.frequency)
+
+		if lc.minFrequency == e.frequency {
+			lc.minFrequency++
+		}
+	}
+}
+
+func (lc *LFUCache[K, V]) bucketOf(frequency int64) *entryList[K, V] {
+	b, err := lc.buckets.Get(frequency)
+	if err != nil {
+		b = newEntryList[K, V]()
+		lc.buckets.Put(frequency, b)
+	}
+
+	return b
+}
@@ -0,0 +1,153 @@
+package cache
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCreateNewLFUCache(t *testing.T) {
+	c, err := NewLFUCache[string, int](2)
+	require.NoError(t, err)
+
+	var _ Cache[string, int] = c
+
+	assert.Equal(t, int64(2), c.Capacity())
+	assert.True(t, c.IsEmpty())
+
+	_, err = NewLFUCache[string, int](-1)
+	internal.AssertErrorEquals(t, errors.New("invalid capacity -1"), err)
+}
+
+func TestLFUCacheEviction(t *testing.T) {
+	sorted := func(keys []string) []string {
+		sort.Strings(keys)
+		return keys
+	}
+
+	testCases := map[string]struct {
+		actualResult   func() []string
+		expectedResult []string
+	}{
+		"should evict least frequently used key": {
+			actualResult: func() []string {
+				c, _ := NewLFUCache[string, int](2)
+				c.Put("a", 1)
+				c.Put("b", 2)
+				_, _ = c.Get("a")
+				_, _ = c.Get("a")
+				_, _ = c.Get("b")
+				c.Put("c", 3)
+
+				return sorted(toSlice(c.Keys()))
+			},
+			expectedResult: []string{"a", "c"},
+		},
+		"should break ties by evicting least recently used key": {
+			actualResult: func() []string {
+				c, _ := NewLFUCache[string, int](2)
+				c.Put("a", 1)
+				c.Put("b", 2)
+				_, _ = c.Get("b")
+				_, _ = c.Get("a")
+				c.Put("c", 3)
+
+				return sorted(toSlice(c.Keys()))
+			},
+			expectedResult: []string{"a", "c"},
+		},
+		"should evict new key before frequently used keys": {
+			actualResult: func() []string {
+				c, _ := NewLFUCache[string, int](2)
+				c.Put("a", 1)
+				_, _ = c.Get("a")
+				c.Put("b", 2)
+				c.Put("c", 3)
+
+				return sorted(toSlice(c.Keys()))
+			},
+			expectedResult: []string{"a", "c"},
+		},
+		"should find next victim after least frequent key was removed": {
+			actualResult: func() []string {
+				c, _ := NewLFUCache[string, int](3)
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				_, _ = c.Get("b")
+				_, _ = c.Get("b")
+				_, _ = c.Get("c")
+				_, _ = c.Remove("a")
+				c.Put("d", 4)
+				c.Put("e", 5)
+
+				return sorted(toSlice(c.Keys()))
+			},
+			expectedResult: []string{"b", "c", "e"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedResult, testCase.actualResult())
+		})
+	}
+}
+
+func TestLFUCacheFrequency(t *testing.T) {
+	c, _ := NewLFUCache[string, int](2)
+	c.Put("a", 1)
+
+	f, err := c.Frequency("a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), f)
+
+	_, _ = c.Get("a")
+	_, _ = c.Peek("a")
+	c.Put("a", 2)
+
+	f, err = c.Frequency("a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), f)
+
+	_, err = c.Frequency("b")
+	internal.AssertErrorEquals(t, errors.New("key b not found in the cache"), err)
+}
+
+func TestLFUCacheTTL(t *testing.T) {
+	clock := newFakeClock()
+	listener := &recordingListener{}
+
+	c, _ := NewLFUCache[string, int](
+		2,
+		WithTTL[string, int](time.Minute),
+		WithClock[string, int](clock),
+		WithEvictionListener[string, int](listener),
+	)
+
+	c.Put("a", 1)
+	_, _ = c.Get("a")
+	_, _ = c.Get("a")
+	c.PutWithTTL("b", 2, 0)
+
+	clock.advance(time.Minute)
+
+	_, err := c.Get("a")
+	internal.AssertErrorEquals(t, errors.New("key a not found in the cache"), err)
+
+	c.Put("c", 3)
+	c.Put("d", 4)
+
+	assert.Equal(t, []string{"a", "b"}, listener.keys)
+	assert.Equal(t, int64(1), c.Stats().Expirations())
+	assert.Equal(t, int64(1), c.Stats().Evictions())
+
+	clock.advance(time.Minute)
+
+	assert.Equal(t, int64(2), c.Purge())
+	assert.True(t, c.IsEmpty())
+}
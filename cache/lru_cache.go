@@ -0,0 +1,183 @@
+package cache
+
+import (
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+	"time"
+)
+
+type LRUCache[K comparable, V comparable] struct {
+	capacity int64
+
+	*config[K, V]
+	stats Stats
+
+	index gmap.Map[K, *entry[K, V]]
+	order *entryList[K, V]
+}
+
+func NewLRUCache[K comparable, V comparable](capacity int64, opts ...Option[K, V]) (*LRUCache[K, V], error) {
+	if capacity <= internal.Zero {
+		return nil, invalidCapacityError(capacity, "NewLRUCache")
+	}
+
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		config:   newConfig[K, V](opts...),
+		index:    gmap.NewHashMap[K, *entry[K, V]](),
+		order:    newEntryList[K, V](),
+	}, nil
+}
+
+func (lc *LRUCache[K, V]) Put(key K, value V) {
+	lc.PutWithTTL(key, value, lc.ttl)
+}
+
+func (lc *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	if e, err := lc.index.Get(key); err == nil {
+		e.value = value
+		e.expiresAt = lc.expiryOf(ttl)
+		lc.order.moveToFirst(e)
+		return
+	}
+
+	if lc.index.Size() >= lc.capacity {
+		lc.evict(lc.order.last)
+	}
+
+	e := newEntry[K, V](key, value, lc.expiryOf(ttl))
+
+	lc.index.Put(key, e)
+	lc.order.addFirst(e)
+}
+
+func (lc *LRUCache[K, V]) Get(key K) (V, error) {
+	e, err := lc.live(key, "LRUCache.Get")
+	if err != nil {
+		lc.stats.misses++
+		return internal.ZeroValueOf[V](), err
+	}
+
+	lc.stats.hits++
+	lc.order.moveToFirst(e)
+
+	return e.value, nil
+}
+
+func (lc *LRUCache[K, V]) Peek(key K) (V, error) {
+	e, err := lc.live(key, "LRUCache.Peek")
+	if err != nil {
+		return internal.ZeroValueOf[V](), err
+	}
+
+	return e.value, nil
+}
+
+func (lc *LRUCache[K, V]) Remove(key K) (V, error) {
+	e, err := lc.live(key, "LRUCache.Remove")
+	if err != nil {
+		return internal.ZeroValueOf[V](), err
+	}
+
+	lc.unlink(e)
+
+	return e.value, nil
+}
+
+func (lc *LRUCache[K, V]) ContainsKey(key K) bool {
+	_, err := lc.live(key, "LRUCache.ContainsKey")
+	return err == nil
+}
+
+func (lc *LRUCache[K, V]) Keys() list.List[K] {
+	res := list.NewArrayList[K]()
+
+	now := lc.clock.Get()
+
+	for curr := lc.order.first; curr != nil; curr = curr.next {
+		if !curr.isExpired(now) {
+			res.Add(curr.key)
+		}
+	}
+
+	return res
+}
+
+func (lc *LRUCache[K, V]) Purge() int64 {
+	var res int64
+
+	now := lc.clock.Get()
+
+	curr := lc.order.last
+	for curr != nil {
+		prev := curr.prev
+
+		if curr.isExpired(now) {
+			lc.expire(curr)
+			res++
+		}
+
+		curr = prev
+	}
+
+	return res
+}
+
+func (lc *LRUCache[K, V]) Size() int64 {
+	return lc.index.Size()
+}
+
+func (lc *LRUCache[K, V]) Capacity() int64 {
+	return lc.capacity
+}
+
+func (lc *LRUCache[K, V]) IsEmpty() bool {
+	return lc.index.IsEmpty()
+}
+
+func (lc *LRUCache[K, V]) Clear() {
+	lc.index.Clear()
+	lc.order = newEntryList[K, V]()
+}
+
+func (lc *LRUCache[K, V]) Stats() Stats {
+	return lc.stats
+}
+
+func (lc *LRUCache[K, V]) live(key K, operation erx.Operation) (*entry[K, V], error) {
+	e, err := lc.index.Get(key)
+	if err != nil {
+		return nil, keyNotFoundError(key, operation)
+	}
+
+	if e.isExpired(lc.clock.Get()) {
+		lc.expire(e)
+		return nil, keyNotFoundError(key, operation)
+	}
+
+	return e, nil
+}
+
+func (lc *LRUCache[K, V]) evict(e *entry[K, V]) {
+	if e.isExpired(lc.clock.Get()) {
+		lc.expire(e)
+		return
+	}
+
+	lc.unlink(e)
+	lc.stats.evictions++
+	lc.notify(e)
+}
+
+func (lc *LRUCache[K, V]) expire(e *entry[K, V]) {
+	lc.unlink(e)
+	lc.stats.expirations++
+	lc.notify(e)
+}
+
+func (lc *LRUCache[K, V]) unlink(e *entry[K, V]) {
+	_, _ = lc.index.Remove(e.key)
+	lc.order.remove(e)
+}
@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateNewLRUCache(t *testing.T) {
+	c, err := NewLRUCache[string, int](2)
+	require.NoError(t, err)
+
+	var _ Cache[string, int] = c
+
+	assert.Equal(t, int64(2), c.Capacity())
+	assert.True(t, c.IsEmpty())
+
+	_, err = NewLRUCache[string, int](0)
+	internal.AssertErrorEquals(t, errors.New("invalid capacity 0"), err)
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	testCases := map[string]struct {
+		actualResult   func() []string
+		expectedResult []string
+	}{
+		"should evict least recently inserted key when nothing was read": {
+			actualResult: func() []string {
+				c, _ := NewLRUCache[string, int](2)
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+
+				return toSlice(c.Keys())
+			},
+			expectedResult: []string{"c", "b"},
+		},
+		"should keep key that was read recently": {
+			actualResult: func() []string {
+				c, _ := NewLRUCache[string, int](2)
+				c.Put("a", 1)
+				c.Put("b", 2)
+				_, _ = c.Get("a")
+				c.Put("c", 3)
+
+				return toSlice(c.Keys())
+			},
+			expectedResult: []string{"c", "a"},
+		},
+		"should treat update of existing key as use": {
+			actualResult: func() []string {
+				c, _ := NewLRUCache[string, int](2)
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("a", 10)
+				c.Put("c", 3)
+
+				return toSlice(c.Keys())
+			},
+			expectedResult: []string{"c", "a"},
+		},
+		"should not change order on peek": {
+			actualResult: func() []string {
+				c, _ := NewLRUCache[string, int](2)
+				c.Put("a", 1)
+				c.Put("b", 2)
+				_, _ = c.Peek("a")
+				c.Put("c", 3)
+
+				return toSlice(c.Keys())
+			},
+			expectedResult: []string{"c", "b"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedResult, testCase.actualResult())
+		})
+	}
+}
+
+func TestLRUCacheGet(t *testing.T) {
+	c, _ := NewLRUCache[string, int](2)
+
+	_, err := c.Get("a")
+	internal.AssertErrorEquals(t, errors.New("key a not found in the cache"), err)
+
+	c.Put("a", 1)
+
+	v, err := c.Get("a")
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	c.Put("a", 2)
+
+	v, err = c.Get("a")
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+}
+
+func TestLRUCacheRemove(t *testing.T) {
+	c, _ := NewLRUCache[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	v, err := c.Remove("a")
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.False(t, c.ContainsKey("a"))
+	assert.Equal(t, int64(1), c.Size())
+
+	_, err = c.Remove("a")
+	internal.AssertErrorEquals(t, errors.New("key a not found in the cache"), err)
+
+	c.Clear()
+	assert.True(t, c.IsEmpty())
+	assert.Equal(t, []string{}, toSlice(c.Keys()))
+}
+
+func TestLRUCacheTTL(t *testing.T) {
+	clock := newFakeClock()
+	listener := &recordingListener{}
+
+	c, _ := NewLRUCache[string, int](
+		3,
+		WithTTL[string, int](time.Minute),
+		WithClock[string, int](clock),
+		WithEvictionListener[string, int](listener),
+	)
+
+	c.Put("a", 1)
+	c.PutWithTTL("b", 2, time.Hour)
+	c.PutWithTTL("c", 3, 0)
+
+	clock.advance(time.Minute)
+
+	assert.False(t, c.ContainsKey("a"))
+	assert.True(t, c.ContainsKey("b"))
+	assert.True(t, c.ContainsKey("c"))
+
+	clock.advance(time.Hour)
+
+	assert.Equal(t, []string{"c"}, toSlice(c.Keys()))
+	assert.Equal(t, int64(1), c.Purge())
+	assert.Equal(t, int64(1), c.Size())
+
+	assert.Equal(t, []string{"a", "b"}, listener.keys)
+	assert.Equal(t, int64(2), c.Stats().Expirations())
+}
+
+func TestLRUCacheStats(t *testing.T) {
+	listener := &recordingListener{}
+
+	c, _ := NewLRUCache[string, int](1, WithEvictionListener[string, int](listener))
+
+	c.Put("a", 1)
+	_, _ = c.Get("a")
+	_, _ = c.Get("b")
+	_, _ = c.Get("a")
+	c.Put("b", 2)
+	_, _ = c.Get("a")
+
+	s := c.Stats()
+	assert.Equal(t, int64(2), s.Hits())
+	assert.Equal(t, int64(2), s.Misses())
+	assert.Equal(t, int64(4), s.Requests())
+	assert.Equal(t, int64(1), s.Evictions())
+	assert.Equal(t, 0.5, s.HitRate())
+	assert.Equal(t, []string{"a"}, listener.keys)
+}
@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"github.com/nsnikhil/go-datastructures/functions/consumer"
+	"github.com/nsnikhil/go-datastructures/functions/supplier"
+	"time"
+)
+
+type Option[K comparable, V comparable] func(c *config[K, V])
+
+// WithTTL sets the time to live used by Put, a zero ttl means entries never expire.
+func WithTTL[K comparable, V comparable](ttl time.Duration) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.ttl = ttl
+	}
+}
+
+// WithClock replaces the wall clock used to expire entries, mostly useful in tests.
+func WithClock[K comparable, V comparable](clock supplier.Supplier[time.Time]) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.clock = clock
+	}
+}
+
+// WithEvictionListener registers a callback invoked for every entry that is
+// evicted due to capacity or dropped because it expired.
+func WithEvictionListener[K comparable, V comparable](listener consumer.BiConsumer[K, V]) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.listener = listener
+	}
+}
+
+type config[K comparable, V comparable] struct {
+	ttl      time.Duration
+	clock    supplier.Supplier[time.Time]
+	listener consumer.BiConsumer[K, V]
+}
+
+func (c *config[K, V]) expiryOf(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+
+	return c.clock.Get().Add(ttl)
+}
+
+func (c *config[K, V]) notify(e *entry[K, V]) {
+	if c.listener != nil {
+		c.listener.Accept(e.key, e.value)
+	}
+}
+
+func newConfig[K comparable, V comparable](opts ...Option[K, V]) *config[K, V] {
+	c := &config[K, V]{clock: systemClock{}}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+type systemClock struct{}
+
+func (sc systemClock) Get() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,38 @@
+package cache
+
+import "github.com/nsnikhil/go-datastructures/internal"
+
+type Stats struct {
+	hits        int64
+	misses      int64
+	evictions   int64
+	expirations int64
+}
+
+func (s Stats) Hits() int64 {
+	return s.hits
+}
+
+func (s Stats) Misses() int64 {
+	return s.misses
+}
+
+func (s Stats) Evictions() int64 {
+	return s.evictions
+}
+
+func (s Stats) Expirations() int64 {
+	return s.expirations
+}
+
+func (s Stats) Requests() int64 {
+	return s.hits + s.misses
+}
+
+func (s Stats) HitRate() float64 {
+	if s.Requests() == internal.Zero {
+		return 0
+	}
+
+	return float64(s.hits) / float64(s.Requests())
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"github.com/nsnikhil/go-datastructures/list"
+	"sync"
+	"time"
+)
+
+// SynchronizedCache guards every call with a single mutex, even reads
+// mutate the recency and frequency bookkeeping of the underlying cache.
+type SynchronizedCache[K comparable, V comparable] struct {
+	mu sync.Mutex
+	c  Cache[K, V]
+}
+
+func NewSynchronizedCache[K comparable, V comparable](c Cache[K, V]) *SynchronizedCache[K, V] {
+	return &SynchronizedCache[K, V]{c: c}
+}
+
+func (sc *SynchronizedCache[K, V]) Put(key K, value V) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.c.Put(key, value)
+}
+
+func (sc *SynchronizedCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.c.PutWithTTL(key, value, ttl)
+}
+
+func (sc *SynchronizedCache[K, V]) Get(key K) (V, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.c.Get(key)
+}
+
+func (sc *SynchronizedCache[K, V]) Peek(key K) (V, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.c.Peek(key)
+}
+
+func (sc *SynchronizedCache[K, V]) Remove(key K) (V, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.c.Remove(key)
+}
+
+func (sc *SynchronizedCache[K, V]) ContainsKey(key K) bool {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.c.ContainsKey(key)
+}
+
+func (sc *SynchronizedCache[K, V]) Keys() list.List[K] {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.c.Keys()
+}
+
+func (sc *SynchronizedCache[K, V]) Purge() int64 {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.c.Purge()
+}
+
+func (sc *SynchronizedCache[K, V]) Size() int64 {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.c.Size()
+}
+
+func (sc *SynchronizedCache[K, V]) Capacity() int64 {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.c.Capacity()
+}
+
+func (sc *SynchronizedCache[K, V]) IsEmpty() bool {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.c.IsEmpty()
+}
+
+func (sc *SynchronizedCache[K, V]) Clear() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.c.Clear()
+}
+
+func (sc *SynchronizedCache[K, V]) Stats() Stats {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	return sc.c.Stats()
+}
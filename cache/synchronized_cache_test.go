@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+func TestSynchronizedCacheConcurrentAccess(t *testing.T) {
+	lru, _ := NewLRUCache[string, int](16)
+	lfu, _ := NewLFUCache[string, int](16)
+
+	for _, c := range []Cache[string, int]{lru, lfu} {
+		sc := NewSynchronizedCache[string, int](c)
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				for j := 0; j < 32; j++ {
+					k := fmt.Sprintf("%d", (i*j)%24)
+					sc.Put(k, j)
+					_, _ = sc.Get(k)
+				}
+			}(i)
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, int64(16), sc.Size())
+		assert.Equal(t, int64(8*32), sc.Stats().Hits())
+	}
+}
@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"github.com/nsnikhil/go-datastructures/list"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (fc *fakeClock) Get() time.Time {
+	return fc.now
+}
+
+func (fc *fakeClock) advance(d time.Duration) {
+	fc.now = fc.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+type recordingListener struct {
+	keys []string
+}
+
+func (rl *recordingListener) Accept(key string, value int) {
+	rl.keys = append(rl.keys, key)
+}
+
+func toSlice[T comparable](l list.List[T]) []T {
+	res := make([]T, 0)
+
+	it := l.Iterator()
+	for it.HasNext() {
+		v, _ := it.Next()
+		res = append(res, v)
+	}
+
+	return res
+}
@@ -0,0 +1,14 @@
+package graph
+
+// Attributes holds arbitrary values keyed by name for a node or an edge, the map
+// handed out is shared with the node or edge so changes made to it are kept.
+type Attributes map[string]interface{}
+
+func (a Attributes) copy() Attributes {
+	res := make(Attributes, len(a))
+	for k, v := range a {
+		res[k] = v
+	}
+
+	return res
+}
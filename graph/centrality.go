@@ -0,0 +1,338 @@
+package graph
+
+import (
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/internal"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+	"math"
+)
+
+const maxRankingIterations = 1000
+
+// indexedAdjacency lists for every node the indexes of the nodes its edges lead
+// to, parallel edges show up once per edge.
+func indexedAdjacency[T any, W Weight](g Graph[T, W]) ([]*Node[T, W], [][]int) {
+	nodes, indexes := indexNodes(g)
+	adj := make([][]int, len(nodes))
+
+	for i, n := range nodes {
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			adj[i] = append(adj[i], indexes[e.next])
+		}
+	}
+
+	return nodes, adj
+}
+
+func toScores[T any, W Weight](nodes []*Node[T, W], scores []float64) gmap.Map[*Node[T, W], float64] {
+	res := gmap.NewHashMap[*Node[T, W], float64]()
+	for i, n := range nodes {
+		res.Put(n, scores[i])
+	}
+
+	return res
+}
+
+// PageRank returns the probability of every node to be reached by a walk that
+// follows a random edge with probability damping and jumps to a random node
+// otherwise.
+func PageRank[T any, W Weight](g Graph[T, W], damping, tolerance float64) (gmap.Map[*Node[T, W], float64], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return pageRank(g, damping, tolerance, "PageRank")
+}
+
+// HITS returns the hub and the authority score of every node.
+func HITS[T any, W Weight](g Graph[T, W], tolerance float64) (gmap.Map[*Node[T, W], float64], gmap.Map[*Node[T, W], float64], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return hits(g, tolerance, "HITS")
+}
+
+// DegreeCentrality returns for every node its share of the other nodes it has an
+// edge to.
+func DegreeCentrality[T any, W Weight](g Graph[T, W]) gmap.Map[*Node[T, W], float64] {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return degreeCentrality(g)
+}
+
+// ClosenessCentrality returns for every node how few edges it takes on average to
+// reach the other nodes.
+func ClosenessCentrality[T any, W Weight](g Graph[T, W]) gmap.Map[*Node[T, W], float64] {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return closenessCentrality(g)
+}
+
+// BetweennessCentrality returns for every node how many of the shortest paths
+// between other nodes go through it.
+func BetweennessCentrality[T any, W Weight](g Graph[T, W]) gmap.Map[*Node[T, W], float64] {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return betweennessCentrality(g)
+}
+
+// pageRank runs the power iteration until the ranks move less than tolerance in
+// total, the rank of nodes without outgoing edges is spread over every node.
+func pageRank[T any, W Weight](g Graph[T, W], damping, tolerance float64, operation erx.Operation) (gmap.Map[*Node[T, W], float64], error) {
+	if damping < 0 || damping > 1 {
+		return nil, invalidParameterError("damping", damping, operation)
+	}
+
+	if tolerance <= 0 {
+		return nil, invalidParameterError("tolerance", tolerance, operation)
+	}
+
+	nodes, adj := indexedAdjacency(g)
+	sz := len(nodes)
+
+	rank := make([]float64, sz)
+	for i := range rank {
+		rank[i] = 1 / float64(sz)
+	}
+
+	for k := 0; k < maxRankingIterations; k++ {
+		dangling := 0.0
+		for i := range nodes {
+			if len(adj[i]) == 0 {
+				dangling += rank[i]
+			}
+		}
+
+		next := make([]float64, sz)
+		for i := range next {
+			next[i] = (1-damping)/float64(sz) + damping*dangling/float64(sz)
+		}
+
+		for i := range nodes {
+			for _, j := range adj[i] {
+				next[j] += damping * rank[i] / float64(len(adj[i]))
+			}
+		}
+
+		diff := 0.0
+		for i := range rank {
+			diff += math.Abs(next[i] - rank[i])
+		}
+
+		rank = next
+
+		if diff < tolerance {
+			return toScores(nodes, rank), nil
+		}
+	}
+
+	return nil, notConvergedError("pagerank", maxRankingIterations, operation)
+}
+
+// degreeCentrality is the number of edges of a node over the number of other
+// nodes, undirected graphs only count every pair of opposite edges once.
+func degreeCentrality[T any, W Weight](g Graph[T, W]) gmap.Map[*Node[T, W], float64] {
+	nodes, adj := indexedAdjacency(g)
+	undirected := isUndirected(g)
+
+	degree := make([]float64, len(nodes))
+	for i := range nodes {
+		degree[i] += float64(len(adj[i]))
+
+		if !undirected {
+			for _, j := range adj[i] {
+				degree[j]++
+			}
+		}
+	}
+
+	if len(nodes) > 1 {
+		for i := range degree {
+			degree[i] /= float64(len(nodes) - 1)
+		}
+	}
+
+	return toScores(nodes, degree)
+}
+
+// shortestHops is a breadth first search from a node counting the edges to every
+// other node, the nodes it cannot reach are left at internal.InvalidIndex. The
+// number of shortest paths to every node and its predecessors on them are kept
+// for brandes.
+type shortestHops struct {
+	dist         []int
+	order        []int
+	paths        []float64
+	predecessors [][]int
+}
+
+func newShortestHops(s int, adj [][]int) *shortestHops {
+	sh := &shortestHops{
+		dist:         make([]int, len(adj)),
+		order:        make([]int, 0, len(adj)),
+		paths:        make([]float64, len(adj)),
+		predecessors: make([][]int, len(adj)),
+	}
+
+	for i := range sh.dist {
+		sh.dist[i] = internal.InvalidIndex
+	}
+
+	sh.dist[s] = 0
+	sh.paths[s] = 1
+
+	q := []int{s}
+	for len(q) > 0 {
+		v := q[0]
+		q = q[1:]
+
+		sh.order = append(sh.order, v)
+
+		for _, w := range adj[v] {
+			if sh.dist[w] == internal.InvalidIndex {
+				sh.dist[w] = sh.dist[v] + 1
+				q = append(q, w)
+			}
+
+			if sh.dist[w] == sh.dist[v]+1 {
+				sh.paths[w] += sh.paths[v]
+				sh.predecessors[w] = append(sh.predecessors[w], v)
+			}
+		}
+	}
+
+	return sh
+}
+
+// closenessCentrality is the number of nodes a node reaches over the sum of the
+// number of edges to reach them, scaled by the share of the graph it reaches so
+// that nodes of small components do not score higher than well connected ones.
+func closenessCentrality[T any, W Weight](g Graph[T, W]) gmap.Map[*Node[T, W], float64] {
+	nodes, adj := indexedAdjacency(g)
+	sz := len(nodes)
+
+	res := make([]float64, sz)
+
+	for s := range nodes {
+		reached, total := 0, 0
+		for _, d := range newShortestHops(s, adj).dist {
+			if d > 0 {
+				reached++
+				total += d
+			}
+		}
+
+		if total > 0 {
+			res[s] = float64(reached) / float64(total) * float64(reached) / float64(sz-1)
+		}
+	}
+
+	return toScores(nodes, res)
+}
+
+// betweennessCentrality is the brandes algorithm, it adds up for every node the
+// share of shortest paths between other pairs of nodes going through it. The
+// shortest paths are counted in number of edges and undirected graphs count every
+// pair once.
+func betweennessCentrality[T any, W Weight](g Graph[T, W]) gmap.Map[*Node[T, W], float64] {
+	nodes, adj := indexedAdjacency(g)
+	sz := len(nodes)
+
+	res := make([]float64, sz)
+
+	for s := range nodes {
+		sh := newShortestHops(s, adj)
+		dependency := make([]float64, sz)
+
+		for i := len(sh.order) - 1; i >= 0; i-- {
+			w := sh.order[i]
+
+			for _, v := range sh.predecessors[w] {
+				dependency[v] += sh.paths[v] / sh.paths[w] * (1 + dependency[w])
+			}
+
+			if w != s {
+				res[w] += dependency[w]
+			}
+		}
+	}
+
+	if isUndirected(g) {
+		for i := range res {
+			res[i] /= 2
+		}
+	}
+
+	return toScores(nodes, res)
+}
+
+// hits alternates between hub scores, the sum of the authority scores of the
+// nodes a node points to, and authority scores, the sum of the hub scores of the
+// nodes pointing to it. Both are normalized to add up to one.
+func hits[T any, W Weight](g Graph[T, W], tolerance float64, operation erx.Operation) (gmap.Map[*Node[T, W], float64], gmap.Map[*Node[T, W], float64], error) {
+	if tolerance <= 0 {
+		return nil, nil, invalidParameterError("tolerance", tolerance, operation)
+	}
+
+	nodes, adj := indexedAdjacency(g)
+	sz := len(nodes)
+
+	normalize := func(scores []float64) {
+		total := 0.0
+		for _, s := range scores {
+			total += s
+		}
+
+		if total == 0 {
+			return
+		}
+
+		for i := range scores {
+			scores[i] /= total
+		}
+	}
+
+	hubs := make([]float64, sz)
+	for i := range hubs {
+		hubs[i] = 1 / float64(sz)
+	}
+
+	var authorities []float64
+
+	for k := 0; k < maxRankingIterations; k++ {
+		authorities = make([]float64, sz)
+		for i := range nodes {
+			for _, j := range adj[i] {
+				authorities[j] += hubs[i]
+			}
+		}
+
+		normalize(authorities)
+
+		next := make([]float64, sz)
+		for i := range nodes {
+			for _, j := range adj[i] {
+				next[i] += authorities[j]
+			}
+		}
+
+		normalize(next)
+
+		diff := 0.0
+		for i := range hubs {
+			diff += math.Abs(next[i] - hubs[i])
+		}
+
+		hubs = next
+
+		if diff < tolerance {
+			return toScores(nodes, hubs), toScores(nodes, authorities), nil
+		}
+	}
+
+	return nil, nil, notConvergedError("hits", maxRankingIterations, operation)
+}
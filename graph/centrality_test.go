@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func scoresByData(scores gmap.Map[*Node[int, int64], float64]) map[int]float64 {
+	res := make(map[int]float64)
+
+	it := scores.Iterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		res[p.First().Data()] = p.Second()
+	}
+
+	return res
+}
+
+func assertScores(t *testing.T, expected map[int]float64, scores gmap.Map[*Node[int, int64], float64]) {
+	actual := scoresByData(scores)
+	require.Equal(t, len(expected), len(actual))
+
+	for k, v := range expected {
+		assert.InDelta(t, v, actual[k], 1e-6, "node %d", k)
+	}
+}
+
+func TestCentrality(t *testing.T) {
+	forEachGraphKind(t, testCentrality)
+}
+
+func testCentrality(t *testing.T, newGraph func() Graph[int, int64]) {
+	star := newGraph()
+	center := NewNode[int](0)
+	for i := 1; i <= 4; i++ {
+		createEdge(star, true, center, NewNode[int](i))
+	}
+
+	assertScores(t, map[int]float64{0: 1, 1: 0.25, 2: 0.25, 3: 0.25, 4: 0.25}, DegreeCentrality(star))
+	assertScores(t, map[int]float64{0: 1, 1: 4.0 / 7, 2: 4.0 / 7, 3: 4.0 / 7, 4: 4.0 / 7}, ClosenessCentrality(star))
+	assertScores(t, map[int]float64{0: 6, 1: 0, 2: 0, 3: 0, 4: 0}, BetweennessCentrality(star))
+
+	path := newGraph()
+	nodes := toNodeList(0, 1, 2)
+	createEdge(path, false, first(nodes.Get(0)), first(nodes.Get(1)))
+	createEdge(path, false, first(nodes.Get(1)), first(nodes.Get(2)))
+
+	assertScores(t, map[int]float64{0: 0.5, 1: 1, 2: 0.5}, DegreeCentrality(path))
+	assertScores(t, map[int]float64{0: 2.0 / 3, 1: 0.5, 2: 0}, ClosenessCentrality(path))
+	assertScores(t, map[int]float64{0: 0, 1: 1, 2: 0}, BetweennessCentrality(path))
+
+	cycle := newGraph()
+	nodes = toNodeList(0, 1, 2)
+	for i := int64(0); i < 3; i++ {
+		createEdge(cycle, false, first(nodes.Get(i)), first(nodes.Get((i+1)%3)))
+	}
+
+	ranks, err := PageRank(cycle, 0.85, 1e-9)
+	require.NoError(t, err)
+	assertScores(t, map[int]float64{0: 1.0 / 3, 1: 1.0 / 3, 2: 1.0 / 3}, ranks)
+
+	ranks, err = PageRank(path, 0.85, 1e-9)
+	require.NoError(t, err)
+
+	rs := scoresByData(ranks)
+	assert.InDelta(t, 1, rs[0]+rs[1]+rs[2], 1e-6)
+	assert.Less(t, rs[0], rs[1])
+	assert.Less(t, rs[1], rs[2])
+
+	_, err = PageRank(path, 1.5, 1e-9)
+	internal.AssertErrorEquals(t, errors.New("invalid damping 1.5"), err)
+
+	_, err = PageRank(path, 0.85, 0)
+	internal.AssertErrorEquals(t, errors.New("invalid tolerance 0"), err)
+
+	fan := newGraph()
+	c := NewNode[int](3)
+	createEdge(fan, false, NewNode[int](1), c)
+	createEdge(fan, false, NewNode[int](2), c)
+
+	hubs, authorities, err := HITS(fan, 1e-9)
+	require.NoError(t, err)
+	assertScores(t, map[int]float64{1: 0.5, 2: 0.5, 3: 0}, hubs)
+	assertScores(t, map[int]float64{1: 0, 2: 0, 3: 1}, authorities)
+
+	_, _, err = HITS(fan, -1)
+	internal.AssertErrorEquals(t, errors.New("invalid tolerance -1"), err)
+
+	// 1 = 0 - 2 joins the center to 1 twice without labels, every pair of
+	// opposite edges counts once towards the degree.
+	parallel := newGraph()
+	hub := NewNode[int](0)
+	one := NewNode[int](1)
+	createWeightedEdge(parallel, true, hub, one, 1)
+	createWeightedEdge(parallel, true, hub, one, 5)
+	createWeightedEdge(parallel, true, hub, NewNode[int](2), 1)
+
+	assertScores(t, map[int]float64{0: 1.5, 1: 1, 2: 0.5}, DegreeCentrality(parallel))
+	assertScores(t, map[int]float64{0: 1, 1: 2.0 / 3, 2: 2.0 / 3}, ClosenessCentrality(parallel))
+	assertScores(t, map[int]float64{0: 1, 1: 0, 2: 0}, BetweennessCentrality(parallel))
+}
+
+func TestPageRankOnAllGraphs(t *testing.T) {
+	graphs := getAllGraphs()
+	for _, g := range getAllGraphs() {
+		graphs = append(graphs, toMatrixGraph(g))
+	}
+
+	for i, g := range graphs {
+		ranks, err := PageRank(g, 0.85, 1e-9)
+		require.NoError(t, err)
+
+		total := 0.0
+		for _, r := range scoresByData(ranks) {
+			total += r
+		}
+
+		assert.InDelta(t, 1, total, 1e-6, "graph %d", i)
+	}
+}
@@ -0,0 +1,314 @@
+package graph
+
+import (
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+)
+
+// undirectedView is a copy of a graph where every edge goes both ways, its
+// BFSIterator walks the original graph as if it was undirected. Loops are left
+// out of the copy and only recorded.
+type undirectedView[T any, W Weight] struct {
+	g         Graph[T, W]
+	order     []*Node[T, W]
+	originals map[*Node[T, W]]*Node[T, W]
+	loop      *Node[T, W]
+}
+
+func newUndirectedView[T any, W Weight](g Graph[T, W]) *undirectedView[T, W] {
+	uv := &undirectedView[T, W]{
+		g:         NewWeightedListGraph[T, W](),
+		order:     make([]*Node[T, W], 0),
+		originals: make(map[*Node[T, W]]*Node[T, W]),
+	}
+
+	nodes, indexes := indexNodes(g)
+	copies := make([]*Node[T, W], len(nodes))
+
+	for i, n := range nodes {
+		copies[i] = NewWeightedNode[T, W](n.data)
+		uv.originals[copies[i]] = n
+		uv.g.AddNode(copies[i])
+	}
+
+	for i, n := range nodes {
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+
+			j := indexes[e.next]
+			if i == j {
+				if uv.loop == nil {
+					uv.loop = n
+				}
+
+				continue
+			}
+
+			if len(copies[i].findEdges(copies[j])) == 0 {
+				_ = uv.g.CreateBiEdge(copies[i], copies[j])
+			}
+		}
+	}
+
+	it := uv.g.BFSIterator()
+	for it.HasNext() {
+		n, _ := it.Next()
+		uv.order = append(uv.order, n)
+	}
+
+	return uv
+}
+
+func (uv *undirectedView[T, W]) toOriginals(colors map[*Node[T, W]]int) gmap.Map[*Node[T, W], int] {
+	res := gmap.NewHashMap[*Node[T, W], int]()
+	for n, c := range colors {
+		res.Put(uv.originals[n], c)
+	}
+
+	return res
+}
+
+// twoColoring colors every node in breadth first order with the opposite color of
+// an already colored neighbor, which is its parent in the search unless the graph
+// has an odd cycle. It returns nil if two adjacent nodes end up with the same color.
+func (uv *undirectedView[T, W]) twoColoring() map[*Node[T, W]]int {
+	if uv.loop != nil {
+		return nil
+	}
+
+	colors := make(map[*Node[T, W]]int)
+
+	for _, n := range uv.order {
+		colors[n] = 0
+
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			if c, ok := colors[e.next]; ok {
+				colors[n] = 1 - c
+				break
+			}
+		}
+	}
+
+	for _, n := range uv.order {
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			if colors[n] == colors[e.next] {
+				return nil
+			}
+		}
+	}
+
+	return colors
+}
+
+func (uv *undirectedView[T, W]) neighborColors(n *Node[T, W], colors map[*Node[T, W]]int) map[int]bool {
+	res := make(map[int]bool)
+
+	it := n.edges.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		if c, ok := colors[e.next]; ok {
+			res[c] = true
+		}
+	}
+
+	return res
+}
+
+func smallestFreeColor(used map[int]bool) int {
+	c := 0
+	for used[c] {
+		c++
+	}
+
+	return c
+}
+
+// greedyColoring gives every node in breadth first order the smallest color not
+// taken by its neighbors.
+func (uv *undirectedView[T, W]) greedyColoring() map[*Node[T, W]]int {
+	colors := make(map[*Node[T, W]]int)
+
+	for _, n := range uv.order {
+		colors[n] = smallestFreeColor(uv.neighborColors(n, colors))
+	}
+
+	return colors
+}
+
+// dsatur colors next the node whose neighbors already use the most distinct
+// colors, ties go to the node with the highest degree and then to the one seen
+// first in breadth first order.
+func (uv *undirectedView[T, W]) dsatur() map[*Node[T, W]]int {
+	colors := make(map[*Node[T, W]]int)
+
+	for len(colors) < len(uv.order) {
+		var next *Node[T, W]
+		saturation := internal.InvalidIndex
+
+		for _, n := range uv.order {
+			if _, ok := colors[n]; ok {
+				continue
+			}
+
+			s := len(uv.neighborColors(n, colors))
+			if s > saturation || (s == saturation && n.edges.Size() > next.edges.Size()) {
+				next, saturation = n, s
+			}
+		}
+
+		colors[next] = smallestFreeColor(uv.neighborColors(next, colors))
+	}
+
+	return colors
+}
+
+// clique grows a clique greedily from every node and returns the size of the
+// largest one, which is a lower bound of the chromatic number.
+func (uv *undirectedView[T, W]) clique() int {
+	res := 0
+
+	for _, n := range uv.order {
+		members := []*Node[T, W]{n}
+
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+
+			joined := true
+			for _, m := range members[1:] {
+				if len(m.findEdges(e.next)) == 0 {
+					joined = false
+					break
+				}
+			}
+
+			if joined {
+				members = append(members, e.next)
+			}
+		}
+
+		if len(members) > res {
+			res = len(members)
+		}
+	}
+
+	return res
+}
+
+func countColors[T any, W Weight](colors map[*Node[T, W]]int) int {
+	res := 0
+	for _, c := range colors {
+		if c+1 > res {
+			res = c + 1
+		}
+	}
+
+	return res
+}
+
+// IsBipartite tells whether the nodes of g can be split in two sets with no edge
+// between two nodes of the same set, the direction of the edges is ignored.
+func IsBipartite[T any, W Weight](g Graph[T, W]) bool {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return isBipartite(g)
+}
+
+// Bipartition returns the two sets IsBipartite splits the nodes of g in.
+func Bipartition[T any, W Weight](g Graph[T, W]) (list.List[*Node[T, W]], list.List[*Node[T, W]], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return bipartition(g, "Bipartition")
+}
+
+// GreedyColoring colors the nodes of g so that no edge joins two nodes of the same
+// color, colors are numbered from zero.
+func GreedyColoring[T any, W Weight](g Graph[T, W]) (gmap.Map[*Node[T, W], int], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return coloring(g, (*undirectedView[T, W]).greedyColoring, "GreedyColoring")
+}
+
+// DSaturColoring is GreedyColoring picking first the nodes with the most colors
+// around them, which usually takes fewer colors.
+func DSaturColoring[T any, W Weight](g Graph[T, W]) (gmap.Map[*Node[T, W], int], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return coloring(g, (*undirectedView[T, W]).dsatur, "DSaturColoring")
+}
+
+// ChromaticBounds returns a lower and an upper bound of the fewest colors needed
+// to color g.
+func ChromaticBounds[T any, W Weight](g Graph[T, W]) (int, int, error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return chromaticBounds(g, "ChromaticBounds")
+}
+
+func isBipartite[T any, W Weight](g Graph[T, W]) bool {
+	return newUndirectedView(g).twoColoring() != nil
+}
+
+func bipartition[T any, W Weight](g Graph[T, W], operation erx.Operation) (list.List[*Node[T, W]], list.List[*Node[T, W]], error) {
+	uv := newUndirectedView(g)
+
+	colors := uv.twoColoring()
+	if colors == nil {
+		return nil, nil, notBipartiteError(operation)
+	}
+
+	left := list.NewArrayList[*Node[T, W]]()
+	right := list.NewArrayList[*Node[T, W]]()
+
+	for _, n := range uv.order {
+		if colors[n] == 0 {
+			left.Add(uv.originals[n])
+		} else {
+			right.Add(uv.originals[n])
+		}
+	}
+
+	return left, right, nil
+}
+
+func coloring[T any, W Weight](g Graph[T, W], color func(uv *undirectedView[T, W]) map[*Node[T, W]]int, operation erx.Operation) (gmap.Map[*Node[T, W], int], error) {
+	uv := newUndirectedView(g)
+	if uv.loop != nil {
+		return nil, loopFoundError(uv.loop.data, operation)
+	}
+
+	return uv.toOriginals(color(uv)), nil
+}
+
+// chromaticBounds returns a lower bound from the largest clique found and from
+// odd cycles, and an upper bound from the best of the greedy and dsatur colorings.
+func chromaticBounds[T any, W Weight](g Graph[T, W], operation erx.Operation) (int, int, error) {
+	uv := newUndirectedView(g)
+	if uv.loop != nil {
+		return internal.Zero, internal.Zero, loopFoundError(uv.loop.data, operation)
+	}
+
+	lower := uv.clique()
+	if lower > 1 && lower < 3 && uv.twoColoring() == nil {
+		lower = 3
+	}
+
+	upper := countColors(uv.greedyColoring())
+	if c := countColors(uv.dsatur()); c < upper {
+		upper = c
+	}
+
+	return lower, upper, nil
+}
@@ -0,0 +1,233 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"testing"
+)
+
+func assertProperColoring(t *testing.T, g Graph[int, int64], colors gmap.Map[*Node[int, int64], int]) {
+	assert.Equal(t, int64(len(nodeData(g))), colors.Size())
+
+	it := g.Edges()
+	for it.HasNext() {
+		e, _ := it.Next()
+		assert.NotEqual(t, first(colors.Get(e.From())), first(colors.Get(e.To())), "edge %d to %d", e.From().Data(), e.To().Data())
+	}
+}
+
+func TestColoring(t *testing.T) {
+	forEachGraphKind(t, testColoring)
+}
+
+func testColoring(t *testing.T, newGraph func() Graph[int, int64]) {
+	cycle := func(bi bool, size int) Graph[int, int64] {
+		g := newGraph()
+
+		nodes := make([]*Node[int, int64], size)
+		for i := range nodes {
+			nodes[i] = NewNode[int](i)
+		}
+
+		for i := range nodes {
+			createEdge(g, bi, nodes[i], nodes[(i+1)%size])
+		}
+
+		return g
+	}
+
+	// 1 < 3 > 4 > 2 is a path once directions are ignored, even though 1 and 2
+	// are only reached through incoming edges.
+	path := func() Graph[int, int64] {
+		g := newGraph()
+		nodes := toNodeList(1, 2, 3, 4)
+		n := func(i int64) *Node[int, int64] { return first(nodes.Get(i)) }
+
+		createEdge(g, false, n(2), n(0))
+		createEdge(g, false, n(3), n(1))
+		createEdge(g, false, n(2), n(3))
+
+		return g
+	}
+
+	// 1 = 2 - 3 joins the first two nodes twice without labels.
+	parallel := func() Graph[int, int64] {
+		g := newGraph()
+		a, b, c := NewNode[int](1), NewNode[int](2), NewNode[int](3)
+
+		createWeightedEdge(g, true, a, b, 1)
+		createWeightedEdge(g, true, a, b, 4)
+		createWeightedEdge(g, true, b, c, 1)
+
+		return g
+	}
+
+	testCases := map[string]struct {
+		graph     func() Graph[int, int64]
+		bipartite bool
+		sides     [2][]int
+		bounds    [2]int
+	}{
+		"test empty graph": {
+			graph:     newGraph,
+			bipartite: true,
+			sides:     [2][]int{{}, {}},
+			bounds:    [2]int{0, 0},
+		},
+		"test directed path": {
+			graph:     path,
+			bipartite: true,
+			sides:     [2][]int{{1, 4}, {2, 3}},
+			bounds:    [2]int{2, 2},
+		},
+		"test even cycle": {
+			graph:     func() Graph[int, int64] { return cycle(true, 6) },
+			bipartite: true,
+			sides:     [2][]int{{0, 2, 4}, {1, 3, 5}},
+			bounds:    [2]int{2, 2},
+		},
+		"test directed odd cycle": {
+			graph:     func() Graph[int, int64] { return cycle(false, 5) },
+			bipartite: false,
+			bounds:    [2]int{3, 3},
+		},
+		"test unlabeled parallel edges": {
+			graph:     parallel,
+			bipartite: true,
+			sides:     [2][]int{{1, 3}, {2}},
+			bounds:    [2]int{2, 2},
+		},
+		"test triangle": {
+			graph:     func() Graph[int, int64] { return cycle(true, 3) },
+			bipartite: false,
+			bounds:    [2]int{3, 3},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			g := testCase.graph()
+
+			assert.Equal(t, testCase.bipartite, IsBipartite(g))
+
+			left, right, err := Bipartition(g)
+			if testCase.bipartite {
+				require.NoError(t, err)
+
+				sides := [][]int{nodeData(toGraph(left)), nodeData(toGraph(right))}
+				assert.ElementsMatch(t, testCase.sides[:], normalizeSides(sides))
+			} else {
+				internal.AssertErrorEquals(t, errors.New("graph is not bipartite"), err)
+			}
+
+			greedy, err := GreedyColoring(g)
+			require.NoError(t, err)
+			assertProperColoring(t, g, greedy)
+
+			dsatur, err := DSaturColoring(g)
+			require.NoError(t, err)
+			assertProperColoring(t, g, dsatur)
+
+			lower, upper, err := ChromaticBounds(g)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.bounds, [2]int{lower, upper})
+		})
+	}
+}
+
+func TestColoringOnAllGraphs(t *testing.T) {
+	graphs := getAllGraphs()
+	for _, g := range getAllGraphs() {
+		graphs = append(graphs, toMatrixGraph(g))
+	}
+
+	for i, g := range graphs {
+		if g.HasLoop() {
+			_, err := GreedyColoring(g)
+			assert.Error(t, err, "graph %d", i)
+			continue
+		}
+
+		dsatur, err := DSaturColoring(g)
+		require.NoError(t, err)
+		assertProperColoring(t, g, dsatur)
+
+		lower, upper, err := ChromaticBounds(g)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, lower, upper, "graph %d", i)
+	}
+}
+
+func toGraph(nodes list.List[*Node[int, int64]]) Graph[int, int64] {
+	g := NewListGraph[int]()
+
+	it := nodes.Iterator()
+	for it.HasNext() {
+		n, _ := it.Next()
+		g.AddNode(NewNode[int](n.Data()))
+	}
+
+	return g
+}
+
+func normalizeSides(sides [][]int) [][]int {
+	for _, s := range sides {
+		sort.Ints(s)
+	}
+
+	return sides
+}
+
+func TestListGraphColoringWithLoop(t *testing.T) {
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+
+	createEdge(g, true, a, b)
+	createEdge(g, false, b, b)
+
+	assert.False(t, IsBipartite(g))
+
+	_, err := GreedyColoring(g)
+	internal.AssertErrorEquals(t, errors.New("node 2 has a loop"), err)
+
+	_, err = DSaturColoring(g)
+	internal.AssertErrorEquals(t, errors.New("node 2 has a loop"), err)
+
+	_, _, err = ChromaticBounds(g)
+	internal.AssertErrorEquals(t, errors.New("node 2 has a loop"), err)
+}
+
+func TestListGraphDSaturOnBipartiteGraph(t *testing.T) {
+	// dsatur always finds a two coloring of a bipartite graph.
+	g := NewListGraph[int]()
+
+	nodes := make([]*Node[int, int64], 8)
+	for i := range nodes {
+		nodes[i] = NewNode[int](i)
+		g.AddNode(nodes[i])
+	}
+
+	for _, p := range [][2]int{{0, 5}, {0, 7}, {5, 2}, {2, 7}, {1, 4}, {4, 3}, {0, 6}, {6, 2}, {1, 6}, {3, 6}, {1, 7}, {3, 5}} {
+		createEdge(g, true, nodes[p[0]], nodes[p[1]])
+	}
+
+	dsatur, err := DSaturColoring(g)
+	require.NoError(t, err)
+	assertProperColoring(t, g, dsatur)
+
+	colors := make(map[int]bool)
+	it := dsatur.Iterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		colors[p.Second()] = true
+	}
+
+	assert.Equal(t, 2, len(colors))
+}
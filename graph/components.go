@@ -0,0 +1,310 @@
+package graph
+
+import (
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/nsnikhil/go-datastructures/queue"
+	"github.com/nsnikhil/go-datastructures/stack"
+)
+
+// undirectedKey identifies the edges going from one node to another with the same
+// label and weight, parallel edges without a label only differ by their weight.
+type undirectedKey[T any, W Weight] struct {
+	from, to *Node[T, W]
+	label    string
+	weight   W
+}
+
+// isUndirected reports if every edge has a reverse edge of the same label and weight,
+// counting parallel edges so each of them needs a reverse edge of its own.
+func isUndirected[T any, W Weight](g Graph[T, W]) bool {
+	counts := make(map[undirectedKey[T, W]]int)
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+			counts[undirectedKey[T, W]{from: n, to: e.next, label: e.label, weight: e.weight}]++
+		}
+	}
+
+	for k, c := range counts {
+		if counts[undirectedKey[T, W]{from: k.to, to: k.from, label: k.label, weight: k.weight}] != c {
+			return false
+		}
+	}
+
+	return true
+}
+
+func tarjan[T any, W Weight](g Graph[T, W]) []list.List[*Node[T, W]] {
+	indexes := make(map[*Node[T, W]]int)
+	lowLinks := make(map[*Node[T, W]]int)
+	onStack := make(map[*Node[T, W]]bool)
+
+	st := stack.NewStack[*Node[T, W]]()
+	res := make([]list.List[*Node[T, W]], 0)
+
+	var connect func(curr *Node[T, W])
+	connect = func(curr *Node[T, W]) {
+		indexes[curr] = len(indexes)
+		lowLinks[curr] = indexes[curr]
+
+		st.Push(curr)
+		onStack[curr] = true
+
+		it := curr.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			nx := e.next
+
+			if _, ok := indexes[nx]; !ok {
+				connect(nx)
+				lowLinks[curr] = minInt(lowLinks[curr], lowLinks[nx])
+			} else if onStack[nx] {
+				lowLinks[curr] = minInt(lowLinks[curr], indexes[nx])
+			}
+		}
+
+		if lowLinks[curr] != indexes[curr] {
+			return
+		}
+
+		component := list.NewArrayList[*Node[T, W]]()
+
+		for {
+			n, _ := st.Pop()
+			onStack[n] = false
+			component.Add(n)
+
+			if n == curr {
+				break
+			}
+		}
+
+		res = append(res, component)
+	}
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		if _, ok := indexes[n]; !ok {
+			connect(n)
+		}
+	}
+
+	return res
+}
+
+func weaklyConnectedComponents[T any, W Weight](g Graph[T, W]) []list.List[*Node[T, W]] {
+	incoming := incomingSteps(g)
+	visited := make(map[*Node[T, W]]bool)
+
+	res := make([]list.List[*Node[T, W]], 0)
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		if visited[n] {
+			continue
+		}
+
+		component := list.NewArrayList[*Node[T, W]]()
+
+		q := queue.NewLinkedQueue[*Node[T, W]]()
+		q.Add(n)
+		visited[n] = true
+
+		for !q.Empty() {
+			curr, _ := q.Remove()
+			component.Add(curr)
+
+			for _, s := range append(outgoingSteps(curr), incoming(curr)...) {
+				if !visited[s.next] {
+					visited[s.next] = true
+					q.Add(s.next)
+				}
+			}
+		}
+
+		res = append(res, component)
+	}
+
+	return res
+}
+
+// Condensation contracts every strongly connected component of g into a single
+// node holding the nodes of the component, the result is always acyclic. The
+// edge between two components carries the lowest weight of the edges joining them.
+func Condensation[T any, W Weight](g Graph[T, W]) Graph[list.List[*Node[T, W]], W] {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	res := NewWeightedListGraph[list.List[*Node[T, W]], W]()
+
+	components := tarjan(g)
+	componentOf := make(map[*Node[T, W]]*Node[list.List[*Node[T, W]], W])
+
+	for _, c := range components {
+		cn := NewWeightedNode[list.List[*Node[T, W]], W](c)
+		res.AddNode(cn)
+
+		it := c.Iterator()
+		for it.HasNext() {
+			n, _ := it.Next()
+			componentOf[n] = cn
+		}
+	}
+
+	for _, c := range components {
+		it := c.Iterator()
+		for it.HasNext() {
+			n, _ := it.Next()
+			from := componentOf[n]
+
+			ei := n.edges.Iterator()
+			for ei.HasNext() {
+				e, _ := ei.Next()
+
+				to := componentOf[e.next]
+				if from == to {
+					continue
+				}
+
+				if c, err := from.findEdge(to); err == nil {
+					if e.weight < c.weight {
+						c.changeWeight(e.weight)
+					}
+
+					continue
+				}
+
+				_ = res.CreateWeightedDiEdge(from, to, e.weight)
+			}
+		}
+	}
+
+	return res
+}
+
+// biconnectivity holds the result of a single depth first search over an undirected
+// graph, the lowest discovery time reachable from the subtree of a node decides if
+// the node is an articulation point or the edge to its parent is a bridge.
+type biconnectivity[T any, W Weight] struct {
+	bridges            list.List[*Edge[T, W]]
+	articulationPoints list.List[*Node[T, W]]
+	components         []list.List[*Node[T, W]]
+}
+
+func newBiconnectivity[T any, W Weight](g Graph[T, W]) *biconnectivity[T, W] {
+	res := &biconnectivity[T, W]{
+		bridges:            list.NewArrayList[*Edge[T, W]](),
+		articulationPoints: list.NewArrayList[*Node[T, W]](),
+		components:         make([]list.List[*Node[T, W]], 0),
+	}
+
+	discovery := make(map[*Node[T, W]]int)
+	low := make(map[*Node[T, W]]int)
+	edges := make([]nodePair[T, W], 0)
+
+	popComponent := func(until nodePair[T, W]) {
+		component := list.NewArrayList[*Node[T, W]]()
+		seen := make(map[*Node[T, W]]bool)
+
+		add := func(n *Node[T, W]) {
+			if !seen[n] {
+				seen[n] = true
+				component.Add(n)
+			}
+		}
+
+		for {
+			p := edges[len(edges)-1]
+			edges = edges[:len(edges)-1]
+
+			add(p.from)
+			add(p.to)
+
+			if p == until {
+				break
+			}
+		}
+
+		res.components = append(res.components, component)
+	}
+
+	var visit func(curr, parent *Node[T, W])
+	visit = func(curr, parent *Node[T, W]) {
+		discovery[curr] = len(discovery)
+		low[curr] = discovery[curr]
+
+		children := 0
+		isArticulationPoint := false
+		skippedParent := false
+
+		it := curr.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			nx := e.next
+
+			if nx == curr {
+				continue
+			}
+
+			// only the edge used to reach curr is skipped, parallel edges still count.
+			if nx == parent && !skippedParent {
+				skippedParent = true
+				continue
+			}
+
+			if _, ok := discovery[nx]; !ok {
+				children++
+
+				p := nodePair[T, W]{from: curr, to: nx}
+				edges = append(edges, p)
+
+				visit(nx, curr)
+				low[curr] = minInt(low[curr], low[nx])
+
+				if low[nx] > discovery[curr] {
+					res.bridges.Add(e.view(curr))
+				}
+
+				if low[nx] >= discovery[curr] {
+					if parent != nil {
+						isArticulationPoint = true
+					}
+
+					popComponent(p)
+				}
+			} else if discovery[nx] < discovery[curr] {
+				low[curr] = minInt(low[curr], discovery[nx])
+				edges = append(edges, nodePair[T, W]{from: curr, to: nx})
+			}
+		}
+
+		if isArticulationPoint || (parent == nil && children > 1) {
+			res.articulationPoints.Add(curr)
+		}
+	}
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		if _, ok := discovery[n]; !ok {
+			visit(n, nil)
+		}
+	}
+
+	return res
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,264 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"testing"
+)
+
+func TestGetConnectedComponentsLeavesGraphUnchanged(t *testing.T) {
+	for i, g := range getAllGraphs() {
+		for _, c := range []Graph[int, int64]{g, toMatrixGraph(g)} {
+			before := labeledWeights(c)
+			_ = c.GetConnectedComponents()
+			assert.Equal(t, before, labeledWeights(c), "graph %d", i)
+		}
+	}
+}
+
+func normalizeComponents(components []list.List[*Node[int, int64]]) [][]int {
+	res := make([][]int, len(components))
+
+	for i, c := range components {
+		res[i] = make([]int, 0)
+
+		it := c.Iterator()
+		for it.HasNext() {
+			n, _ := it.Next()
+			res[i] = append(res[i], n.data)
+		}
+
+		sort.Ints(res[i])
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return intSliceComparator{}.Compare(res[i], res[j]) < 0
+	})
+
+	return res
+}
+
+func TestListGraphStronglyConnectedComponents(t *testing.T) {
+	for i, g := range getAllGraphs() {
+		before := simplifyGraph(g)
+
+		kosaraju := g.GetConnectedComponents()
+		assert.True(t, internal.AreMapsSame[int, []int](before, simplifyGraph(g), intSliceComparator{}), "graph %d", i)
+
+		assert.Equal(t, normalizeComponents(kosaraju), normalizeComponents(g.StronglyConnectedComponents()), "graph %d", i)
+	}
+
+	g, _ := graphThree()
+	assert.Equal(t, [][]int{{3}, {0, 1, 2}}, normalizeComponents(g.StronglyConnectedComponents()))
+}
+
+func TestListGraphWeaklyConnectedComponents(t *testing.T) {
+	for i, gs := range getAllGraphSets() {
+		res := gs.g.WeaklyConnectedComponents()
+
+		if gs.ps.hasProperty(connected) {
+			assert.Equal(t, 1, len(res), "graph %d", i)
+		}
+
+		if gs.ps.hasProperty(disConnected) {
+			assert.Greater(t, len(res), 1, "graph %d", i)
+		}
+
+		var total int64
+		for _, c := range res {
+			total += c.Size()
+		}
+
+		assert.Equal(t, len(simplifyGraph(gs.g)), int(total), "graph %d", i)
+	}
+
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+	d := NewNode[int](4)
+
+	createEdge(g, false, a, b)
+	createEdge(g, false, c, b)
+	g.AddNode(d)
+
+	assert.Equal(t, [][]int{{4}, {1, 2, 3}}, normalizeComponents(g.WeaklyConnectedComponents()))
+	assert.Equal(t, [][]int{{1}, {2}, {3}, {4}}, normalizeComponents(g.StronglyConnectedComponents()))
+}
+
+func TestCondensation(t *testing.T) {
+	for i, g := range getAllGraphs() {
+		c := Condensation(g)
+
+		assert.False(t, c.HasCycle(), "graph %d", i)
+		assert.False(t, c.HasLoop(), "graph %d", i)
+
+		components := make([]list.List[*Node[int, int64]], 0)
+
+		it := c.Nodes()
+		for it.HasNext() {
+			n, _ := it.Next()
+			components = append(components, n.Data())
+		}
+
+		assert.Equal(t, normalizeComponents(g.StronglyConnectedComponents()), normalizeComponents(components), "graph %d", i)
+	}
+
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	d := NewNode[int](3)
+
+	createWeightedEdge(g, false, a, b, 1)
+	createWeightedEdge(g, false, b, a, 1)
+	createWeightedEdge(g, false, a, d, 7)
+	createWeightedEdge(g, false, b, d, 4)
+
+	c := Condensation(g)
+
+	res := make([]*Edge[list.List[*Node[int, int64]], int64], 0)
+
+	it := c.Edges()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res = append(res, e)
+	}
+
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, int64(4), res[0].Weight())
+	assert.Equal(t, int64(2), res[0].From().Data().Size())
+	assert.Equal(t, int64(1), res[0].To().Data().Size())
+}
+
+func TestListGraphBiconnectivity(t *testing.T) {
+	// 1 --- 2     5
+	//  \   /     / \
+	//    3 --- 4 --- 6 --- 7
+	newGraph := func() Graph[int, int64] {
+		g := NewListGraph[int]()
+
+		nodes := make([]*Node[int, int64], 8)
+		for i := 1; i < 8; i++ {
+			nodes[i] = NewNode[int](i)
+		}
+
+		createEdge(g, true, nodes[1], nodes[2], nodes[3])
+		createEdge(g, true, nodes[2], nodes[3])
+		createEdge(g, true, nodes[3], nodes[4])
+		createEdge(g, true, nodes[4], nodes[5], nodes[6])
+		createEdge(g, true, nodes[5], nodes[6])
+		createEdge(g, true, nodes[6], nodes[7])
+
+		return g
+	}
+
+	g := newGraph()
+
+	bridges, err := g.Bridges()
+	require.NoError(t, err)
+
+	bridgeData := make([][]int, 0)
+
+	it := bridges.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		pair := []int{e.From().Data(), e.To().Data()}
+		sort.Ints(pair)
+		bridgeData = append(bridgeData, pair)
+	}
+
+	assert.ElementsMatch(t, [][]int{{3, 4}, {6, 7}}, bridgeData)
+
+	points, err := g.ArticulationPoints()
+	require.NoError(t, err)
+
+	pointData := make([]int, 0)
+
+	pi := points.Iterator()
+	for pi.HasNext() {
+		n, _ := pi.Next()
+		pointData = append(pointData, n.Data())
+	}
+
+	assert.ElementsMatch(t, []int{3, 4, 6}, pointData)
+
+	components, err := g.BiconnectedComponents()
+	require.NoError(t, err)
+	assert.Equal(t, [][]int{{3, 4}, {6, 7}, {1, 2, 3}, {4, 5, 6}}, normalizeComponents(components))
+}
+
+func TestListGraphBiconnectivityWithParallelEdges(t *testing.T) {
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+
+	createEdge(g, true, a, b)
+	createEdge(g, true, a, b)
+	createEdge(g, true, b, c)
+
+	bridges, err := g.Bridges()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), bridges.Size())
+
+	e, _ := bridges.Get(0)
+	assert.ElementsMatch(t, []int{2, 3}, []int{e.From().Data(), e.To().Data()})
+
+	points, err := g.ArticulationPoints()
+	require.NoError(t, err)
+	assert.True(t, isListEqual(toNodeList(2), points))
+}
+
+func TestUnlabeledParallelBiEdgesWithDifferentWeights(t *testing.T) {
+	lg, _ := parallelBiEdgesGraph()
+
+	for name, g := range map[string]Graph[int, int64]{"list": lg, "matrix": toMatrixGraph(lg)} {
+		t.Run(name, func(t *testing.T) {
+			assert.True(t, isUndirected(g))
+
+			for _, mst := range []func() (Graph[int, int64], int64, error){g.KruskalMST, g.PrimMST} {
+				tree, weight, err := mst()
+				require.NoError(t, err)
+				assert.Equal(t, int64(3), weight)
+				assert.Equal(t, []string{"1>2:=2", "1>3:=1", "2>1:=2", "3>1:=1"}, edgeList(tree))
+			}
+
+			bridges, err := g.Bridges()
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), bridges.Size())
+
+			points, err := g.ArticulationPoints()
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), points.Size())
+
+			path, err := EulerianPath(g)
+			require.NoError(t, err)
+			assert.Equal(t, int64(5), path.Size())
+
+			_, err = EulerianCircuit(g)
+			internal.AssertErrorEquals(t, errors.New("eulerian circuit not found in the graph"), err)
+
+			assertScores(t, map[int]float64{1: 1.5, 2: 1.5, 3: 1}, DegreeCentrality(g))
+		})
+	}
+}
+
+func TestListGraphBiconnectivityFailure(t *testing.T) {
+	g, _ := graphThree()
+
+	_, err := g.Bridges()
+	internal.AssertErrorEquals(t, errors.New("graph is not undirected"), err)
+
+	_, err = g.ArticulationPoints()
+	internal.AssertErrorEquals(t, errors.New("graph is not undirected"), err)
+
+	_, err = g.BiconnectedComponents()
+	internal.AssertErrorEquals(t, errors.New("graph is not undirected"), err)
+}
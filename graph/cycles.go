@@ -0,0 +1,532 @@
+package graph
+
+import (
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+)
+
+const maxHamiltonianSize = 20
+
+func indexNodes[T any, W Weight](g Graph[T, W]) ([]*Node[T, W], map[*Node[T, W]]int) {
+	nodes := make([]*Node[T, W], 0)
+	indexes := make(map[*Node[T, W]]int)
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		indexes[n] = len(nodes)
+		nodes = append(nodes, n)
+	}
+
+	return nodes, indexes
+}
+
+// FindCycle returns the nodes of a directed cycle of g, the first node of the
+// cycle is not repeated at the end.
+func FindCycle[T any, W Weight](g Graph[T, W]) (list.List[*Node[T, W]], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return found(findCycle(g), "cycle", "FindCycle")
+}
+
+// ElementaryCycles returns every cycle of g that goes through each of its nodes
+// once.
+func ElementaryCycles[T any, W Weight](g Graph[T, W]) []list.List[*Node[T, W]] {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return elementaryCycles(g)
+}
+
+// NegativeCycle returns the nodes of a cycle of g whose weights add up to less
+// than zero.
+func NegativeCycle[T any, W Weight](g Graph[T, W]) (list.List[*Node[T, W]], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return found(negativeCycle(g), "negative cycle", "NegativeCycle")
+}
+
+// EulerianPath returns a walk through every edge of g once.
+func EulerianPath[T any, W Weight](g Graph[T, W]) (list.List[*Node[T, W]], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return found(eulerian(g, false), "eulerian path", "EulerianPath")
+}
+
+// EulerianCircuit returns a walk through every edge of g once that ends where it
+// started, the start node is repeated at the end.
+func EulerianCircuit[T any, W Weight](g Graph[T, W]) (list.List[*Node[T, W]], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return found(eulerian(g, true), "eulerian circuit", "EulerianCircuit")
+}
+
+// HamiltonianPath returns a path through every node of g once, the search takes
+// exponential time so graphs of more than 20 nodes are rejected.
+func HamiltonianPath[T any, W Weight](g Graph[T, W]) (list.List[*Node[T, W]], error) {
+	return hamiltonianWalk(g, false, "hamiltonian path", "HamiltonianPath")
+}
+
+// HamiltonianCycle is HamiltonianPath closing back to its first node.
+func HamiltonianCycle[T any, W Weight](g Graph[T, W]) (list.List[*Node[T, W]], error) {
+	return hamiltonianWalk(g, true, "hamiltonian cycle", "HamiltonianCycle")
+}
+
+func hamiltonianWalk[T any, W Weight](g Graph[T, W], cycle bool, kind string, operation erx.Operation) (list.List[*Node[T, W]], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	if nodes, _ := indexNodes(g); len(nodes) > maxHamiltonianSize {
+		return nil, graphTooLargeError(len(nodes), operation)
+	}
+
+	return found(hamiltonian(g, cycle), kind, operation)
+}
+
+// found turns the nil list returned when a search fails into an error.
+func found[T any, W Weight](res list.List[*Node[T, W]], kind string, operation erx.Operation) (list.List[*Node[T, W]], error) {
+	if res == nil {
+		return nil, cycleNotFoundError(kind, operation)
+	}
+
+	return res, nil
+}
+
+// findCycle returns the nodes of the first directed cycle found by a depth first
+// search, the first node of the cycle is not repeated at the end.
+func findCycle[T any, W Weight](g Graph[T, W]) list.List[*Node[T, W]] {
+	onPath := make(map[*Node[T, W]]bool)
+	done := make(map[*Node[T, W]]bool)
+	path := make([]*Node[T, W], 0)
+
+	var visit func(curr *Node[T, W]) list.List[*Node[T, W]]
+	visit = func(curr *Node[T, W]) list.List[*Node[T, W]] {
+		onPath[curr] = true
+		path = append(path, curr)
+
+		it := curr.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			nx := e.next
+
+			if done[nx] {
+				continue
+			}
+
+			if onPath[nx] {
+				i := len(path) - 1
+				for path[i] != nx {
+					i--
+				}
+
+				return list.NewArrayList[*Node[T, W]](path[i:]...)
+			}
+
+			if res := visit(nx); res != nil {
+				return res
+			}
+		}
+
+		onPath[curr] = false
+		done[curr] = true
+		path = path[:len(path)-1]
+		return nil
+	}
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		if done[n] {
+			continue
+		}
+
+		if res := visit(n); res != nil {
+			return res
+		}
+	}
+
+	return nil
+}
+
+// elementaryCycles is the johnson algorithm, for every node s it searches the cycles
+// whose smallest node is s inside the strongly connected component of s in the graph
+// induced by s and the nodes after it. A blocked node is only unblocked once a cycle
+// through it is found, which keeps the search linear in the number of cycles.
+func elementaryCycles[T any, W Weight](g Graph[T, W]) []list.List[*Node[T, W]] {
+	nodes, indexes := indexNodes(g)
+
+	// parallel edges would report the same cycle more than once.
+	adj := make([][]int, len(nodes))
+	for i, n := range nodes {
+		seen := make(map[int]bool)
+
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+
+			j := indexes[e.next]
+			if !seen[j] {
+				seen[j] = true
+				adj[i] = append(adj[i], j)
+			}
+		}
+	}
+
+	res := make([]list.List[*Node[T, W]], 0)
+
+	for s := range nodes {
+		component := componentFrom(s, adj)
+
+		blocked := make([]bool, len(nodes))
+		blockedBy := make([]map[int]bool, len(nodes))
+		for i := range blockedBy {
+			blockedBy[i] = make(map[int]bool)
+		}
+
+		stack := make([]int, 0)
+
+		var unblock func(u int)
+		unblock = func(u int) {
+			blocked[u] = false
+
+			for w := range blockedBy[u] {
+				delete(blockedBy[u], w)
+				if blocked[w] {
+					unblock(w)
+				}
+			}
+		}
+
+		var circuit func(v int) bool
+		circuit = func(v int) bool {
+			found := false
+
+			stack = append(stack, v)
+			blocked[v] = true
+
+			for _, w := range adj[v] {
+				if !component[w] {
+					continue
+				}
+
+				if w == s {
+					cycle := list.NewArrayList[*Node[T, W]]()
+					for _, i := range stack {
+						cycle.Add(nodes[i])
+					}
+
+					res = append(res, cycle)
+					found = true
+				} else if !blocked[w] && circuit(w) {
+					found = true
+				}
+			}
+
+			if found {
+				unblock(v)
+			} else {
+				for _, w := range adj[v] {
+					if component[w] {
+						blockedBy[w][v] = true
+					}
+				}
+			}
+
+			stack = stack[:len(stack)-1]
+			return found
+		}
+
+		circuit(s)
+	}
+
+	return res
+}
+
+// componentFrom returns the strongly connected component of s in the graph induced
+// by s and the nodes after it, as the nodes both reachable from and reaching s.
+func componentFrom(s int, adj [][]int) []bool {
+	radj := make([][]int, len(adj))
+	for v := s; v < len(adj); v++ {
+		for _, w := range adj[v] {
+			if w >= s {
+				radj[w] = append(radj[w], v)
+			}
+		}
+	}
+
+	reach := func(adj [][]int) []bool {
+		seen := make([]bool, len(adj))
+		seen[s] = true
+
+		q := []int{s}
+		for len(q) > 0 {
+			v := q[0]
+			q = q[1:]
+
+			for _, w := range adj[v] {
+				if w >= s && !seen[w] {
+					seen[w] = true
+					q = append(q, w)
+				}
+			}
+		}
+
+		return seen
+	}
+
+	forward, backward := reach(adj), reach(radj)
+
+	res := make([]bool, len(adj))
+	for i := range res {
+		res[i] = forward[i] && backward[i]
+	}
+
+	return res
+}
+
+// negativeCycle starts every node at cost zero, as if a virtual source was joined
+// to all of them, so that a negative cycle anywhere in the graph is detected. The
+// node still relaxable after all rounds leads back into the cycle through at most
+// as many predecessors as there are nodes.
+func negativeCycle[T any, W Weight](g Graph[T, W]) list.List[*Node[T, W]] {
+	cm := gmap.NewHashMap[*Node[T, W], W]()
+	pm := gmap.NewHashMap[*Node[T, W], *Node[T, W]]()
+
+	sz := 0
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		cm.Put(n, internal.Zero)
+		pm.Put(n, nil)
+		sz++
+	}
+
+	curr := relaxEdges(g, cm, pm)
+
+	for i := 0; i < sz && curr != nil; i++ {
+		curr = first(pm.Get(curr))
+	}
+
+	if curr == nil {
+		return nil
+	}
+
+	res := list.NewLinkedList[*Node[T, W]]()
+
+	for n := curr; ; {
+		res.AddFirst(n)
+
+		n = first(pm.Get(n))
+		if n == curr {
+			break
+		}
+	}
+
+	return res
+}
+
+// eulerian runs hierholzer over every edge of the graph, undirected graphs walk
+// each pair of opposite edges once. The returned walk repeats the start node at
+// the end when it is a circuit.
+func eulerian[T any, W Weight](g Graph[T, W], circuit bool) list.List[*Node[T, W]] {
+	nodes, indexes := indexNodes(g)
+	undirected := isUndirected(g)
+
+	ends := make([][2]int, 0)
+	adj := make([][]int, len(nodes))
+	degree := make([]int, len(nodes))
+
+	for i, n := range nodes {
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			j := indexes[e.next]
+
+			if undirected && j < i {
+				continue
+			}
+
+			id := len(ends)
+			ends = append(ends, [2]int{i, j})
+
+			adj[i] = append(adj[i], id)
+			degree[i]++
+
+			if undirected {
+				degree[j]++
+				if j != i {
+					adj[j] = append(adj[j], id)
+				}
+			} else {
+				degree[j]--
+			}
+		}
+	}
+
+	if len(ends) == 0 {
+		return list.NewArrayList[*Node[T, W]]()
+	}
+
+	start := internal.InvalidIndex
+	unbalanced := 0
+
+	for i := range nodes {
+		if start == internal.InvalidIndex && len(adj[i]) > 0 {
+			start = i
+		}
+	}
+
+	// a path has to start at an odd node, or at the node with an extra outgoing edge.
+	for i := range nodes {
+		if undirected && degree[i]%2 != 0 {
+			if unbalanced == 0 {
+				start = i
+			}
+
+			unbalanced++
+		}
+
+		if !undirected && degree[i] != 0 {
+			if degree[i] < -1 || degree[i] > 1 {
+				return nil
+			}
+
+			if degree[i] == 1 {
+				start = i
+			}
+
+			unbalanced++
+		}
+	}
+
+	if (circuit && unbalanced != 0) || unbalanced > 2 {
+		return nil
+	}
+
+	used := make([]bool, len(ends))
+	next := make([]int, len(nodes))
+
+	walk := make([]int, 0, len(ends)+1)
+	stack := []int{start}
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+
+		for next[v] < len(adj[v]) && used[adj[v][next[v]]] {
+			next[v]++
+		}
+
+		if next[v] == len(adj[v]) {
+			walk = append(walk, v)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		id := adj[v][next[v]]
+		used[id] = true
+
+		w := ends[id][1]
+		if w == v {
+			w = ends[id][0]
+		}
+
+		stack = append(stack, w)
+	}
+
+	// edges outside the component of start are left unused.
+	if len(walk) != len(ends)+1 {
+		return nil
+	}
+
+	res := list.NewLinkedList[*Node[T, W]]()
+	for _, i := range walk {
+		res.AddFirst(nodes[i])
+	}
+
+	return res
+}
+
+// hamiltonian keeps for every subset of nodes the set of nodes a path covering
+// exactly that subset can end at, both as bit masks. A cycle fixes the first node
+// as the start and has to close back to it.
+func hamiltonian[T any, W Weight](g Graph[T, W], cycle bool) list.List[*Node[T, W]] {
+	nodes, indexes := indexNodes(g)
+	sz := len(nodes)
+
+	if sz == 0 {
+		return list.NewArrayList[*Node[T, W]]()
+	}
+
+	incoming := make([]uint32, sz)
+	for i, n := range nodes {
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			incoming[indexes[e.next]] |= 1 << i
+		}
+	}
+
+	full := uint32(1)<<sz - 1
+	ends := make([]uint32, full+1)
+
+	for i := 0; i < sz; i++ {
+		if !cycle || i == 0 {
+			ends[1<<i] = 1 << i
+		}
+	}
+
+	for mask := uint32(1); mask < full; mask++ {
+		if ends[mask] == 0 {
+			continue
+		}
+
+		for w := 0; w < sz; w++ {
+			if mask&(1<<w) == 0 && ends[mask]&incoming[w] != 0 {
+				ends[mask|1<<w] |= 1 << w
+			}
+		}
+	}
+
+	last := internal.InvalidIndex
+	for v := 0; v < sz; v++ {
+		if ends[full]&(1<<v) == 0 {
+			continue
+		}
+
+		if !cycle || incoming[0]&(1<<v) != 0 {
+			last = v
+			break
+		}
+	}
+
+	if last == internal.InvalidIndex {
+		return nil
+	}
+
+	res := list.NewLinkedList[*Node[T, W]]()
+
+	for mask, v := full, last; ; {
+		res.AddFirst(nodes[v])
+
+		prev := mask &^ (1 << v)
+		if prev == 0 {
+			break
+		}
+
+		for u := 0; u < sz; u++ {
+			if ends[prev]&incoming[v]&(1<<u) != 0 {
+				mask, v = prev, u
+				break
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,296 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"testing"
+)
+
+// normalizeCycles rotates every cycle to start at its smallest node and sorts them.
+func normalizeCycles(cycles []list.List[*Node[int, int64]]) [][]int {
+	res := make([][]int, 0, len(cycles))
+
+	for _, c := range cycles {
+		data := make([]int, 0)
+
+		it := c.Iterator()
+		for it.HasNext() {
+			n, _ := it.Next()
+			data = append(data, n.Data())
+		}
+
+		start := 0
+		for i, d := range data {
+			if d < data[start] {
+				start = i
+			}
+		}
+
+		res = append(res, append(data[start:], data[:start]...))
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return intSliceComparator{}.Compare(res[i], res[j]) < 0
+	})
+
+	return res
+}
+
+func isCycleOf(g Graph[int, int64], cycle list.List[*Node[int, int64]]) bool {
+	if cycle.Size() == 0 {
+		return false
+	}
+
+	last, _ := cycle.Get(cycle.Size() - 1)
+	start, _ := cycle.Get(0)
+
+	ok, err := g.AreAdjacent(last, start)
+	if err != nil || !ok {
+		return false
+	}
+
+	_, err = pathWeight(g, cycle)
+	return err == nil
+}
+
+func TestListGraphFindCycle(t *testing.T) {
+	for _, g := range getGraphs[int](cyclic) {
+		cycle, err := FindCycle(g)
+		require.NoError(t, err)
+		assert.True(t, isCycleOf(g, cycle))
+	}
+
+	for _, g := range getGraphs[int](ACyclic) {
+		_, err := FindCycle(g)
+		internal.AssertErrorEquals(t, errors.New("cycle not found in the graph"), err)
+	}
+}
+
+func TestListGraphElementaryCycles(t *testing.T) {
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+	d := NewNode[int](4)
+
+	createEdge(g, false, a, b)
+	createEdge(g, false, b, c, a)
+	createEdge(g, false, c, a, c, d)
+
+	assert.Equal(t, [][]int{{3}, {1, 2}, {1, 2, 3}}, normalizeCycles(ElementaryCycles(g)))
+
+	complete := NewListGraph[int]()
+
+	nodes := make([]*Node[int, int64], 4)
+	for i := range nodes {
+		nodes[i] = NewNode[int](i)
+	}
+
+	for i := range nodes {
+		for j := range nodes {
+			if i != j {
+				createEdge(complete, false, nodes[i], nodes[j])
+			}
+		}
+	}
+
+	cycles := ElementaryCycles(complete)
+	assert.Equal(t, 20, len(cycles))
+
+	for _, cycle := range cycles {
+		assert.True(t, isCycleOf(complete, cycle))
+	}
+
+	for _, g := range getGraphs[int](ACyclic) {
+		assert.Empty(t, ElementaryCycles(g))
+	}
+}
+
+func TestListGraphNegativeCycle(t *testing.T) {
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+	d := NewNode[int](4)
+	e := NewNode[int](5)
+
+	createWeightedEdge(g, false, d, a, 2)
+	createWeightedEdge(g, false, a, b, 1)
+	createWeightedEdge(g, false, b, c, -3)
+	createWeightedEdge(g, false, c, a, 1)
+	createWeightedEdge(g, false, c, e, 2)
+
+	cycle, err := NegativeCycle(g)
+	require.NoError(t, err)
+	assert.True(t, isCycleOf(g, cycle))
+	assert.Equal(t, [][]int{{1, 2, 3}}, normalizeCycles([]list.List[*Node[int, int64]]{cycle}))
+
+	require.NoError(t, g.DeleteEdge(b, c))
+	createWeightedEdge(g, false, b, c, -2)
+
+	_, err = NegativeCycle(g)
+	internal.AssertErrorEquals(t, errors.New("negative cycle not found in the graph"), err)
+}
+
+// eulerianEdges counts how often every edge is walked, an undirected edge is keyed
+// by its smaller end first.
+func eulerianEdges(walk list.List[*Node[int, int64]], undirected bool) map[[2]int]int {
+	res := make(map[[2]int]int)
+
+	it := walk.Iterator()
+	prev, _ := it.Next()
+
+	for it.HasNext() {
+		curr, _ := it.Next()
+
+		key := [2]int{prev.Data(), curr.Data()}
+		if undirected && key[0] > key[1] {
+			key[0], key[1] = key[1], key[0]
+		}
+
+		res[key]++
+		prev = curr
+	}
+
+	return res
+}
+
+func TestListGraphEulerian(t *testing.T) {
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+	d := NewNode[int](4)
+
+	createEdge(g, true, a, b)
+	createEdge(g, true, b, c)
+	createEdge(g, true, c, a, d)
+
+	path, err := EulerianPath(g)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), path.Size())
+	assert.Equal(t, map[[2]int]int{{1, 2}: 1, {2, 3}: 1, {1, 3}: 1, {3, 4}: 1}, eulerianEdges(path, true))
+
+	start, _ := path.Get(0)
+	assert.Contains(t, []int{3, 4}, start.Data())
+
+	_, err = EulerianCircuit(g)
+	internal.AssertErrorEquals(t, errors.New("eulerian circuit not found in the graph"), err)
+
+	dg := NewListGraph[int]()
+
+	a, b, c, d = NewNode[int](1), NewNode[int](2), NewNode[int](3), NewNode[int](4)
+
+	createEdge(dg, false, a, b, c)
+	createEdge(dg, false, b, c)
+	createEdge(dg, false, c, a, d)
+	createEdge(dg, false, d, a)
+
+	circuit, err := EulerianCircuit(dg)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), circuit.Size())
+
+	start, _ = circuit.Get(0)
+	end, _ := circuit.Get(6)
+	assert.Equal(t, start, end)
+
+	assert.Equal(t, map[[2]int]int{{1, 2}: 1, {1, 3}: 1, {2, 3}: 1, {3, 1}: 1, {3, 4}: 1, {4, 1}: 1}, eulerianEdges(circuit, false))
+
+	require.NoError(t, dg.DeleteEdge(d, a))
+
+	path, err = EulerianPath(dg)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), path.Size())
+
+	start, _ = path.Get(0)
+	end, _ = path.Get(5)
+	assert.Equal(t, 1, start.Data())
+	assert.Equal(t, 4, end.Data())
+
+	_, err = EulerianCircuit(dg)
+	internal.AssertErrorEquals(t, errors.New("eulerian circuit not found in the graph"), err)
+
+	disconnected := NewListGraph[int]()
+
+	a, b, c, d = NewNode[int](1), NewNode[int](2), NewNode[int](3), NewNode[int](4)
+
+	createEdge(disconnected, false, a, b)
+	createEdge(disconnected, false, b, a)
+	createEdge(disconnected, false, c, d)
+	createEdge(disconnected, false, d, c)
+
+	_, err = EulerianPath(disconnected)
+	internal.AssertErrorEquals(t, errors.New("eulerian path not found in the graph"), err)
+}
+
+func TestListGraphHamiltonian(t *testing.T) {
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+	d := NewNode[int](4)
+
+	createEdge(g, false, c, a)
+	createEdge(g, false, a, d)
+	createEdge(g, false, d, b)
+	createEdge(g, false, a, b)
+
+	path, err := HamiltonianPath(g)
+	require.NoError(t, err)
+	assert.True(t, isListEqual(toNodeList(3, 1, 4, 2), path))
+
+	_, err = HamiltonianCycle(g)
+	internal.AssertErrorEquals(t, errors.New("hamiltonian cycle not found in the graph"), err)
+
+	createEdge(g, false, b, c)
+
+	cycle, err := HamiltonianCycle(g)
+	require.NoError(t, err)
+	assert.True(t, isCycleOf(g, cycle))
+	assert.Equal(t, [][]int{{1, 4, 2, 3}}, normalizeCycles([]list.List[*Node[int, int64]]{cycle}))
+
+	// the petersen graph has a hamiltonian path but no hamiltonian cycle.
+	petersen := NewListGraph[int]()
+
+	nodes := make([]*Node[int, int64], 10)
+	for i := range nodes {
+		nodes[i] = NewNode[int](i)
+	}
+
+	for i := 0; i < 5; i++ {
+		createEdge(petersen, true, nodes[i], nodes[(i+1)%5], nodes[i+5])
+		createEdge(petersen, true, nodes[i+5], nodes[(i+2)%5+5])
+	}
+
+	path, err = HamiltonianPath(petersen)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), path.Size())
+
+	_, err = pathWeight(petersen, path)
+	require.NoError(t, err)
+
+	_, err = HamiltonianCycle(petersen)
+	internal.AssertErrorEquals(t, errors.New("hamiltonian cycle not found in the graph"), err)
+}
+
+func TestListGraphHamiltonianFailure(t *testing.T) {
+	g := NewListGraph[int]()
+
+	for i := 0; i <= maxHamiltonianSize; i++ {
+		g.AddNode(NewNode[int](i))
+	}
+
+	_, err := HamiltonianPath(g)
+	internal.AssertErrorEquals(t, errors.New("graph of size 21 is too large"), err)
+
+	_, err = HamiltonianCycle(g)
+	internal.AssertErrorEquals(t, errors.New("graph of size 21 is too large"), err)
+}
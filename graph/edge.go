@@ -4,37 +4,97 @@ import (
 	"github.com/nsnikhil/go-datastructures/internal"
 )
 
-type edge[T any] struct {
-	next   *Node[T]
-	weight int64
+// Edge is a read only view of a directed edge, it is what the public API
+// hands out so callers cannot corrupt the adjacency of a node.
+type Edge[T any, W Weight] struct {
+	from   *Node[T, W]
+	to     *Node[T, W]
+	weight W
+	label  string
+	source *edge[T, W]
 }
 
-func (e *edge[T]) changeWeight(weight int64) {
+func (e *Edge[T, W]) From() *Node[T, W] {
+	return e.from
+}
+
+func (e *Edge[T, W]) To() *Node[T, W] {
+	return e.to
+}
+
+func (e *Edge[T, W]) Weight() W {
+	return e.weight
+}
+
+// Label tells apart parallel edges between the same nodes, it is empty for edges
+// created without one.
+func (e *Edge[T, W]) Label() string {
+	return e.label
+}
+
+// Attributes returns the attributes of the underlying edge, an edge that is not
+// part of the graph, like the ones of a min cut, has attributes of its own.
+func (e *Edge[T, W]) Attributes() Attributes {
+	return e.source.attributes
+}
+
+type edge[T any, W Weight] struct {
+	next       *Node[T, W]
+	weight     W
+	label      string
+	attributes Attributes
+}
+
+func (e *edge[T, W]) view(from *Node[T, W]) *Edge[T, W] {
+	return &Edge[T, W]{
+		from:   from,
+		to:     e.next,
+		weight: e.weight,
+		label:  e.label,
+		source: e,
+	}
+}
+
+func (e *edge[T, W]) changeWeight(weight W) {
 	e.weight = weight
 }
 
-func (e *edge[T]) changeNext(next *Node[T]) {
+func (e *edge[T, W]) changeNext(next *Node[T, W]) {
 	e.next = next
 }
 
-func (e *edge[T]) copy() *edge[T] {
-	return &edge[T]{
-		next:   e.next.copy(),
-		weight: e.weight,
+func (e *edge[T, W]) copy() *edge[T, W] {
+	return e.copyTo(e.next.copy())
+}
+
+// copyTo keeps the weight, label and attributes of e for an edge to next.
+func (e *edge[T, W]) copyTo(next *Node[T, W]) *edge[T, W] {
+	return &edge[T, W]{
+		next:       next,
+		weight:     e.weight,
+		label:      e.label,
+		attributes: e.attributes.copy(),
 	}
 }
 
-func newDiEdge[T any](next *Node[T]) *edge[T] {
-	return newEdge[T](next, internal.Zero)
+func newDiEdge[T any, W Weight](next *Node[T, W]) *edge[T, W] {
+	return newEdge[T, W](next, internal.Zero)
+}
+
+func newWeightedDiEdge[T any, W Weight](next *Node[T, W], weight W) *edge[T, W] {
+	return newEdge[T, W](next, weight)
 }
 
-func newWeightedDiEdge[T any](next *Node[T], weight int64) *edge[T] {
-	return newEdge[T](next, weight)
+func newLabeledDiEdge[T any, W Weight](next *Node[T, W], label string, weight W) *edge[T, W] {
+	e := newEdge[T, W](next, weight)
+	e.label = label
+	return e
 }
 
-func newEdge[T any](next *Node[T], weight int64) *edge[T] {
-	return &edge[T]{
-		next:   next,
-		weight: weight,
+func newEdge[T any, W Weight](next *Node[T, W], weight W) *edge[T, W] {
+	return &edge[T, W]{
+		next:       next,
+		weight:     weight,
+		attributes: make(Attributes),
 	}
 }
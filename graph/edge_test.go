@@ -9,14 +9,14 @@ import (
 func TestCreateNewDiEdge(t *testing.T) {
 	for i := 0; i < math.MaxInt8; i++ {
 		n := NewNode[int](i)
-		assert.Equal(t, &edge[int]{next: n}, newDiEdge[int](n))
+		assert.Equal(t, &edge[int, int64]{next: n, attributes: Attributes{}}, newDiEdge[int](n))
 	}
 }
 
 func TestCreateNewWeightedDiEdge(t *testing.T) {
 	for i := int64(0); i < math.MaxInt8; i++ {
 		n := NewNode[int64](i)
-		assert.Equal(t, &edge[int64]{next: n, weight: i}, newWeightedDiEdge[int64](n, i))
+		assert.Equal(t, &edge[int64, int64]{next: n, weight: i, attributes: Attributes{}}, newWeightedDiEdge[int64](n, i))
 	}
 }
 
@@ -46,3 +46,29 @@ func TestEdgeCopy(t *testing.T) {
 
 	assert.Equal(t, e, e.copy())
 }
+
+func TestEdgeView(t *testing.T) {
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+
+	e := newWeightedDiEdge[int](b, 7).view(a)
+
+	assert.Equal(t, a, e.From())
+	assert.Equal(t, b, e.To())
+	assert.Equal(t, int64(7), e.Weight())
+}
+
+func TestEdgeViewsShareAttributes(t *testing.T) {
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+
+	e := newWeightedDiEdge[int](b, 7)
+
+	e.view(a).Attributes()["color"] = "red"
+
+	assert.Equal(t, Attributes{"color": "red"}, e.view(a).Attributes())
+	assert.Equal(t, Attributes{"color": "red"}, e.attributes)
+	c := e.copy()
+	c.attributes["color"] = "blue"
+	assert.Equal(t, Attributes{"color": "red"}, e.view(a).Attributes())
+}
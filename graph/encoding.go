@@ -0,0 +1,391 @@
+package graph
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Labeler renders the data of a node as text, fmt.Sprint is used when it is nil.
+type Labeler[T any] func(data T) string
+
+// LabelParser builds the data of a node back from its label.
+type LabelParser[T any] func(label string) (T, error)
+
+func (l Labeler[T]) label(data T) string {
+	if l == nil {
+		return fmt.Sprint(data)
+	}
+
+	return l(data)
+}
+
+// encodedGraph numbers the nodes of a graph in the order of Nodes, the formats
+// refer to nodes by these ids since labels are not required to be unique.
+type encodedGraph[T any, W Weight] struct {
+	nodes []*Node[T, W]
+	edges []*Edge[T, W]
+	ids   map[*Node[T, W]]int
+}
+
+func newEncodedGraph[T any, W Weight](g Graph[T, W]) *encodedGraph[T, W] {
+	nodes, ids := indexNodes(g)
+
+	res := &encodedGraph[T, W]{nodes: nodes, ids: ids, edges: make([]*Edge[T, W], 0)}
+
+	for _, n := range nodes {
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			res.edges = append(res.edges, e.view(n))
+		}
+	}
+
+	return res
+}
+
+type jsonNode struct {
+	ID    int    `json:"id"`
+	Label string `json:"label"`
+}
+
+type jsonEdge[W Weight] struct {
+	From   int    `json:"from"`
+	To     int    `json:"to"`
+	Label  string `json:"label,omitempty"`
+	Weight W      `json:"weight"`
+}
+
+type jsonGraph[W Weight] struct {
+	Nodes []jsonNode    `json:"nodes"`
+	Edges []jsonEdge[W] `json:"edges"`
+}
+
+// WriteJSON writes g as a list of nodes and a list of directed edges between
+// their ids, an undirected edge shows up once in each direction.
+func WriteJSON[T any, W Weight](w io.Writer, g Graph[T, W], labeler Labeler[T]) error {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	eg := newEncodedGraph(g)
+
+	doc := jsonGraph[W]{Nodes: make([]jsonNode, 0, len(eg.nodes)), Edges: make([]jsonEdge[W], 0, len(eg.edges))}
+
+	for i, n := range eg.nodes {
+		doc.Nodes = append(doc.Nodes, jsonNode{ID: i, Label: labeler.label(n.data)})
+	}
+
+	for _, e := range eg.edges {
+		doc.Edges = append(doc.Edges, jsonEdge[W]{From: eg.ids[e.from], To: eg.ids[e.to], Label: e.label, Weight: e.weight})
+	}
+
+	if err := json.NewEncoder(w).Encode(doc); err != nil {
+		return graphWriteError(err, "WriteJSON")
+	}
+
+	return nil
+}
+
+// ReadJSON builds a list graph from the format written by WriteJSON.
+func ReadJSON[T any, W Weight](r io.Reader, parser LabelParser[T]) (Graph[T, W], error) {
+	var doc jsonGraph[W]
+
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, invalidGraphFormatError(err, "ReadJSON")
+	}
+
+	g := NewWeightedListGraph[T, W]()
+	nodes := make(map[int]*Node[T, W])
+
+	for _, jn := range doc.Nodes {
+		if _, ok := nodes[jn.ID]; ok {
+			return nil, invalidGraphFormatError(fmt.Errorf("duplicate node id %d", jn.ID), "ReadJSON")
+		}
+
+		data, err := parser(jn.Label)
+		if err != nil {
+			return nil, invalidGraphFormatError(err, "ReadJSON")
+		}
+
+		nodes[jn.ID] = NewWeightedNode[T, W](data)
+		g.AddNode(nodes[jn.ID])
+	}
+
+	for _, je := range doc.Edges {
+		from, ok := nodes[je.From]
+		if !ok {
+			return nil, invalidGraphFormatError(fmt.Errorf("unknown node id %d", je.From), "ReadJSON")
+		}
+
+		to, ok := nodes[je.To]
+		if !ok {
+			return nil, invalidGraphFormatError(fmt.Errorf("unknown node id %d", je.To), "ReadJSON")
+		}
+
+		if err := g.CreateLabeledDiEdge(from, to, je.Label, je.Weight); err != nil {
+			return nil, invalidGraphFormatError(err, "ReadJSON")
+		}
+	}
+
+	return g, nil
+}
+
+// WriteEdgeList writes one line per edge as the labels of both ends followed by
+// the weight and the label of the edge if it has one, a node without edges is
+// written on a line of its own. Labels with whitespace cannot be read back by
+// ReadEdgeList.
+func WriteEdgeList[T any, W Weight](w io.Writer, g Graph[T, W], labeler Labeler[T]) error {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	eg := newEncodedGraph(g)
+	bw := bufio.NewWriter(w)
+
+	for _, n := range eg.nodes {
+		if n.edges.Size() == 0 {
+			_, _ = fmt.Fprintln(bw, labeler.label(n.data))
+		}
+	}
+
+	for _, e := range eg.edges {
+		if e.label == "" {
+			_, _ = fmt.Fprintln(bw, labeler.label(e.from.data), labeler.label(e.to.data), e.weight)
+			continue
+		}
+
+		_, _ = fmt.Fprintln(bw, labeler.label(e.from.data), labeler.label(e.to.data), e.weight, e.label)
+	}
+
+	if err := bw.Flush(); err != nil {
+		return graphWriteError(err, "WriteEdgeList")
+	}
+
+	return nil
+}
+
+// ReadEdgeList builds a list graph from lines of whitespace separated labels, a
+// line is either a single node, or an edge with an optional weight followed by an
+// optional edge label. Nodes are matched by label, empty lines and lines starting
+// with # are skipped.
+func ReadEdgeList[T any, W Weight](r io.Reader, parser LabelParser[T]) (Graph[T, W], error) {
+	g := NewWeightedListGraph[T, W]()
+	nodes := make(map[string]*Node[T, W])
+
+	nodeOf := func(label string) (*Node[T, W], error) {
+		if n, ok := nodes[label]; ok {
+			return n, nil
+		}
+
+		data, err := parser(label)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes[label] = NewWeightedNode[T, W](data)
+		g.AddNode(nodes[label])
+
+		return nodes[label], nil
+	}
+
+	sc := bufio.NewScanner(r)
+
+	for line := 1; sc.Scan(); line++ {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		if len(fields) > 4 {
+			return nil, invalidGraphFormatError(fmt.Errorf("line %d has %d fields", line, len(fields)), "ReadEdgeList")
+		}
+
+		from, err := nodeOf(fields[0])
+		if err != nil {
+			return nil, invalidGraphFormatError(err, "ReadEdgeList")
+		}
+
+		if len(fields) == 1 {
+			continue
+		}
+
+		to, err := nodeOf(fields[1])
+		if err != nil {
+			return nil, invalidGraphFormatError(err, "ReadEdgeList")
+		}
+
+		var weight W
+		if len(fields) >= 3 {
+			if weight, err = parseWeight[W](fields[2]); err != nil {
+				return nil, invalidGraphFormatError(fmt.Errorf("line %d: %w", line, err), "ReadEdgeList")
+			}
+		}
+
+		label := ""
+		if len(fields) == 4 {
+			label = fields[3]
+		}
+
+		if err := g.CreateLabeledDiEdge(from, to, label, weight); err != nil {
+			return nil, invalidGraphFormatError(fmt.Errorf("line %d: %w", line, err), "ReadEdgeList")
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, invalidGraphFormatError(err, "ReadEdgeList")
+	}
+
+	return g, nil
+}
+
+// parseWeight rejects a fraction for an integer weight type instead of truncating it,
+// a float32 weight is rounded like any other float32 conversion.
+func parseWeight[W Weight](s string) (W, error) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return W(i), nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if half := 0.5; err != nil || (W(half) == 0 && float64(W(f)) != f) {
+		return 0, fmt.Errorf("invalid weight %s", s)
+	}
+
+	return W(f), nil
+}
+
+// WriteDOT writes g as a graphviz digraph, every edge is labeled with its weight
+// after its own label if it has one.
+// The graphviz weight attribute is left out as it only takes non negative
+// integers meant for the layout.
+func WriteDOT[T any, W Weight](w io.Writer, g Graph[T, W], labeler Labeler[T]) error {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	eg := newEncodedGraph(g)
+	bw := bufio.NewWriter(w)
+
+	_, _ = fmt.Fprintln(bw, "digraph {")
+
+	for i, n := range eg.nodes {
+		_, _ = fmt.Fprintf(bw, "\tn%d [label=%s];\n", i, strconv.Quote(labeler.label(n.data)))
+	}
+
+	for _, e := range eg.edges {
+		label := fmt.Sprint(e.weight)
+		if e.label != "" {
+			label = fmt.Sprintf("%s: %v", e.label, e.weight)
+		}
+
+		_, _ = fmt.Fprintf(bw, "\tn%d -> n%d [label=%s];\n", eg.ids[e.from], eg.ids[e.to], strconv.Quote(label))
+	}
+
+	_, _ = fmt.Fprintln(bw, "}")
+
+	if err := bw.Flush(); err != nil {
+		return graphWriteError(err, "WriteDOT")
+	}
+
+	return nil
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphMLNode struct {
+	ID   string      `xml:"id,attr"`
+	Data graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+// WriteGraphML writes g as a directed graphml document with the node label, the
+// edge weight and the edge label as data keys, edges without a label leave it out.
+func WriteGraphML[T any, W Weight](w io.Writer, g Graph[T, W], labeler Labeler[T]) error {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	eg := newEncodedGraph(g)
+
+	weightType := "long"
+	if half := 0.5; W(half) != 0 {
+		weightType = "double"
+	}
+
+	doc := graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "label", For: "node", Name: "label", Type: "string"},
+			{ID: "weight", For: "edge", Name: "weight", Type: weightType},
+			{ID: "edgelabel", For: "edge", Name: "label", Type: "string"},
+		},
+		Graph: graphMLGraph{ID: "G", EdgeDefault: "directed"},
+	}
+
+	for i, n := range eg.nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID:   fmt.Sprintf("n%d", i),
+			Data: graphMLData{Key: "label", Value: labeler.label(n.data)},
+		})
+	}
+
+	for _, e := range eg.edges {
+		ge := graphMLEdge{
+			Source: fmt.Sprintf("n%d", eg.ids[e.from]),
+			Target: fmt.Sprintf("n%d", eg.ids[e.to]),
+			Data:   []graphMLData{{Key: "weight", Value: fmt.Sprint(e.weight)}},
+		}
+
+		if e.label != "" {
+			ge.Data = append(ge.Data, graphMLData{Key: "edgelabel", Value: e.label})
+		}
+
+		doc.Graph.Edges = append(doc.Graph.Edges, ge)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return graphWriteError(err, "WriteGraphML")
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "\t")
+
+	if err := enc.Encode(doc); err != nil {
+		return graphWriteError(err, "WriteGraphML")
+	}
+
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return graphWriteError(err, "WriteGraphML")
+	}
+
+	return nil
+}
@@ -0,0 +1,363 @@
+package graph
+
+import (
+	"bytes"
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func weightsOf(g Graph[int, int64]) map[[2]int]int64 {
+	res := make(map[[2]int]int64)
+
+	it := g.Edges()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res[[2]int{e.From().Data(), e.To().Data()}] = e.Weight()
+	}
+
+	return res
+}
+
+type failingWriter struct{}
+
+func (fw failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for i, g := range getAllGraphs() {
+		var buf bytes.Buffer
+		require.NoError(t, WriteJSON[int, int64](&buf, g, strconv.Itoa))
+
+		res, err := ReadJSON[int, int64](&buf, strconv.Atoi)
+		require.NoError(t, err)
+
+		assert.True(t, internal.AreMapsSame[int, []int](simplifyGraph(g), simplifyGraph(res), intSliceComparator{}), "graph %d", i)
+		assert.Equal(t, weightsOf(g), weightsOf(res), "graph %d", i)
+	}
+}
+
+func TestJSONRoundTripKeepsLabels(t *testing.T) {
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+
+	g.AddNode(a)
+	g.AddNode(b)
+
+	require.NoError(t, g.CreateLabeledDiEdge(a, b, "road", 7))
+	require.NoError(t, g.CreateLabeledDiEdge(a, b, "rail", 3))
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteJSON[int, int64](&buf, g, strconv.Itoa))
+
+	res, err := ReadJSON[int, int64](&buf, strconv.Atoi)
+	require.NoError(t, err)
+
+	e, err := res.FindEdge(getNodeWithVal(res, 1), getNodeWithVal(res, 2), "road")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), e.Weight())
+
+	e, err = res.FindEdge(getNodeWithVal(res, 1), getNodeWithVal(res, 2), "rail")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), e.Weight())
+}
+
+func parallelEdgesGraph() Graph[int, int64] {
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+
+	g.AddNode(a)
+	g.AddNode(b)
+
+	_ = g.CreateLabeledDiEdge(a, b, "road", 7)
+	_ = g.CreateLabeledDiEdge(a, b, "rail", 3)
+	_ = g.CreateWeightedDiEdge(b, a, 1)
+
+	return g
+}
+
+func TestRoundTripKeepsParallelEdges(t *testing.T) {
+	formats := map[string]struct {
+		write func(w io.Writer, g Graph[int, int64]) error
+		read  func(r io.Reader) (Graph[int, int64], error)
+	}{
+		"json": {
+			write: func(w io.Writer, g Graph[int, int64]) error { return WriteJSON[int, int64](w, g, nil) },
+			read:  func(r io.Reader) (Graph[int, int64], error) { return ReadJSON[int, int64](r, strconv.Atoi) },
+		},
+		"edge list": {
+			write: func(w io.Writer, g Graph[int, int64]) error { return WriteEdgeList[int, int64](w, g, nil) },
+			read:  func(r io.Reader) (Graph[int, int64], error) { return ReadEdgeList[int, int64](r, strconv.Atoi) },
+		},
+	}
+
+	for name, format := range formats {
+		t.Run(name, func(t *testing.T) {
+			g := parallelEdgesGraph()
+
+			var buf bytes.Buffer
+			require.NoError(t, format.write(&buf, g))
+
+			res, err := format.read(&buf)
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]int64{"1>2:road": 7, "1>2:rail": 3, "2>1:": 1}, labeledWeights(res))
+		})
+	}
+}
+
+func TestWriteKeepsEdgeLabels(t *testing.T) {
+	var dot, graphML bytes.Buffer
+
+	require.NoError(t, WriteDOT[int, int64](&dot, parallelEdgesGraph(), nil))
+	assert.Contains(t, dot.String(), `[label="road: 7"];`)
+	assert.Contains(t, dot.String(), `[label="rail: 3"];`)
+	assert.Contains(t, dot.String(), `[label="1"];`)
+
+	require.NoError(t, WriteGraphML[int, int64](&graphML, parallelEdgesGraph(), nil))
+	assert.Contains(t, graphML.String(), `<data key="edgelabel">road</data>`)
+	assert.Contains(t, graphML.String(), `<data key="edgelabel">rail</data>`)
+	assert.Equal(t, 2, strings.Count(graphML.String(), `<data key="edgelabel">`))
+}
+
+func TestEdgeListRoundTrip(t *testing.T) {
+	for i, g := range getAllGraphs() {
+		var buf bytes.Buffer
+		require.NoError(t, WriteEdgeList[int, int64](&buf, g, nil))
+
+		res, err := ReadEdgeList[int, int64](&buf, strconv.Atoi)
+		require.NoError(t, err)
+
+		assert.True(t, internal.AreMapsSame[int, []int](simplifyGraph(g), simplifyGraph(res), intSliceComparator{}), "graph %d", i)
+		assert.Equal(t, weightsOf(g), weightsOf(res), "graph %d", i)
+	}
+}
+
+func TestReadEdgeList(t *testing.T) {
+	text := `
+# a triangle and a lone node
+a b 1.5
+b c
+c a 0.25
+d
+`
+
+	g, err := ReadEdgeList[string, float64](strings.NewReader(text), func(label string) (string, error) {
+		return label, nil
+	})
+	require.NoError(t, err)
+
+	res := make(map[string]float64)
+
+	it := g.Edges()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res[e.From().Data()+e.To().Data()] = e.Weight()
+	}
+
+	assert.Equal(t, map[string]float64{"ab": 1.5, "bc": 0, "ca": 0.25}, res)
+	labels := make([]string, 0)
+
+	ni := g.Nodes()
+	for ni.HasNext() {
+		n, _ := ni.Next()
+		labels = append(labels, n.Data())
+	}
+
+	assert.ElementsMatch(t, []string{"a", "b", "c", "d"}, labels)
+}
+
+func TestEdgeListRoundTripWithFloat32Weights(t *testing.T) {
+	g := NewWeightedListGraph[int, float32]()
+
+	a := NewWeightedNode[int, float32](1)
+	b := NewWeightedNode[int, float32](2)
+
+	g.AddNode(a)
+	g.AddNode(b)
+
+	require.NoError(t, g.CreateWeightedDiEdge(a, b, 0.1))
+	require.NoError(t, g.CreateLabeledDiEdge(b, a, "back", 2.75))
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteEdgeList[int, float32](&buf, g, strconv.Itoa))
+
+	res, err := ReadEdgeList[int, float32](&buf, strconv.Atoi)
+	require.NoError(t, err)
+
+	weights := make(map[string]float32)
+
+	it := res.Edges()
+	for it.HasNext() {
+		e, _ := it.Next()
+		weights[strconv.Itoa(e.From().Data())+">"+strconv.Itoa(e.To().Data())+":"+e.Label()] = e.Weight()
+	}
+
+	assert.Equal(t, map[string]float32{"1>2:": 0.1, "2>1:back": 2.75}, weights)
+}
+
+func TestReadFailure(t *testing.T) {
+	testCases := map[string]struct {
+		read          func() error
+		expectedError error
+	}{
+		"should fail for malformed json": {
+			read: func() error {
+				_, err := ReadJSON[int, int64](strings.NewReader(`{"nodes":`), strconv.Atoi)
+				return err
+			},
+			expectedError: errors.New("invalid graph format: unexpected EOF"),
+		},
+		"should fail for a duplicate node id": {
+			read: func() error {
+				_, err := ReadJSON[int, int64](strings.NewReader(`{"nodes":[{"id":1,"label":"1"},{"id":1,"label":"2"}]}`), strconv.Atoi)
+				return err
+			},
+			expectedError: errors.New("invalid graph format: duplicate node id 1"),
+		},
+		"should fail for an edge to an unknown node": {
+			read: func() error {
+				_, err := ReadJSON[int, int64](strings.NewReader(`{"nodes":[{"id":1,"label":"1"}],"edges":[{"from":1,"to":2}]}`), strconv.Atoi)
+				return err
+			},
+			expectedError: errors.New("invalid graph format: unknown node id 2"),
+		},
+		"should fail when the label cannot be parsed": {
+			read: func() error {
+				_, err := ReadEdgeList[int, int64](strings.NewReader("1 x"), strconv.Atoi)
+				return err
+			},
+			expectedError: errors.New("invalid graph format: strconv.Atoi: parsing \"x\": invalid syntax"),
+		},
+		"should fail for a fraction as an integer weight": {
+			read: func() error {
+				_, err := ReadEdgeList[int, int64](strings.NewReader("1 2 3\n2 3 1.5"), strconv.Atoi)
+				return err
+			},
+			expectedError: errors.New("invalid graph format: line 2: invalid weight 1.5"),
+		},
+		"should fail for a line with too many fields": {
+			read: func() error {
+				_, err := ReadEdgeList[int, int64](strings.NewReader("1 2 3 road 4"), strconv.Atoi)
+				return err
+			},
+			expectedError: errors.New("invalid graph format: line 1 has 5 fields"),
+		},
+		"should fail for an edge label used twice": {
+			read: func() error {
+				_, err := ReadEdgeList[int, int64](strings.NewReader("1 2 3 road\n1 2 4 road"), strconv.Atoi)
+				return err
+			},
+			expectedError: errors.New("invalid graph format: line 2: edge 1 to 2 labeled \"road\" already exists in the graph"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			internal.AssertErrorEquals(t, testCase.expectedError, testCase.read())
+		})
+	}
+}
+
+func smallMatrixGraph() Graph[int, int64] {
+	g := NewMatrixGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+
+	_ = g.CreateWeightedDiEdge(a, b, 3)
+	_ = g.CreateWeightedDiEdge(b, c, -1)
+
+	return g
+}
+
+func TestWriteDOT(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteDOT[int, int64](&buf, smallMatrixGraph(), func(data int) string {
+		return "node \"" + strconv.Itoa(data) + "\""
+	}))
+
+	expected := `digraph {
+	n0 [label="node \"1\""];
+	n1 [label="node \"2\""];
+	n2 [label="node \"3\""];
+	n0 -> n1 [label="3"];
+	n1 -> n2 [label="-1"];
+}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteGraphML(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteGraphML[int, int64](&buf, smallMatrixGraph(), func(data int) string {
+		return "<" + strconv.Itoa(data) + ">"
+	}))
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+	<key id="label" for="node" attr.name="label" attr.type="string"></key>
+	<key id="weight" for="edge" attr.name="weight" attr.type="long"></key>
+	<key id="edgelabel" for="edge" attr.name="label" attr.type="string"></key>
+	<graph id="G" edgedefault="directed">
+		<node id="n0">
+			<data key="label">&lt;1&gt;</data>
+		</node>
+		<node id="n1">
+			<data key="label">&lt;2&gt;</data>
+		</node>
+		<node id="n2">
+			<data key="label">&lt;3&gt;</data>
+		</node>
+		<edge source="n0" target="n1">
+			<data key="weight">3</data>
+		</edge>
+		<edge source="n1" target="n2">
+			<data key="weight">-1</data>
+		</edge>
+	</graph>
+</graphml>
+`
+
+	assert.Equal(t, expected, buf.String())
+
+	fg := NewWeightedMatrixGraph[int, float64]()
+	fg.AddNode(NewWeightedNode[int, float64](1))
+
+	buf.Reset()
+	require.NoError(t, WriteGraphML[int, float64](&buf, fg, nil))
+	assert.Contains(t, buf.String(), `attr.type="double"`)
+}
+
+func TestWriteFailure(t *testing.T) {
+	g := smallMatrixGraph()
+
+	for name, write := range map[string]func(w io.Writer) error{
+		"json":      func(w io.Writer) error { return WriteJSON[int, int64](w, g, nil) },
+		"edge list": func(w io.Writer) error { return WriteEdgeList[int, int64](w, g, nil) },
+		"dot":       func(w io.Writer) error { return WriteDOT[int, int64](w, g, nil) },
+		"graphml":   func(w io.Writer) error { return WriteGraphML[int, int64](w, g, nil) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			internal.AssertErrorEquals(t, errors.New("failed to write graph: disk full"), write(failingWriter{}))
+		})
+	}
+}
@@ -0,0 +1,404 @@
+package graph
+
+import (
+	"fmt"
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/nsnikhil/go-datastructures/queue"
+)
+
+type flowArc[W Weight] struct {
+	to       int
+	rev      int
+	capacity W
+	flow     W
+}
+
+func (fa *flowArc[W]) residual() W {
+	return fa.capacity - fa.flow
+}
+
+// flowNetwork is the residual network used by the max flow algorithms, every arc
+// is paired with a reverse arc of zero capacity stored at index rev of its target.
+type flowNetwork[W Weight] struct {
+	arcs [][]*flowArc[W]
+}
+
+func newFlowNetwork[W Weight](size int) *flowNetwork[W] {
+	return &flowNetwork[W]{arcs: make([][]*flowArc[W], size)}
+}
+
+func (fn *flowNetwork[W]) addArc(from, to int, capacity W) *flowArc[W] {
+	a := &flowArc[W]{to: to, rev: len(fn.arcs[to]), capacity: capacity}
+	b := &flowArc[W]{to: from, rev: len(fn.arcs[from])}
+
+	fn.arcs[from] = append(fn.arcs[from], a)
+	fn.arcs[to] = append(fn.arcs[to], b)
+
+	return a
+}
+
+func (fn *flowNetwork[W]) push(a *flowArc[W], amount W) {
+	a.flow += amount
+	fn.arcs[a.to][a.rev].flow -= amount
+}
+
+func (fn *flowNetwork[W]) edmondsKarp(source, sink int) W {
+	var total W
+
+	for {
+		from := make([]int, len(fn.arcs))
+		through := make([]*flowArc[W], len(fn.arcs))
+		visited := make([]bool, len(fn.arcs))
+
+		q := queue.NewLinkedQueue[int]()
+		q.Add(source)
+		visited[source] = true
+
+		for !q.Empty() && !visited[sink] {
+			u, _ := q.Remove()
+
+			for _, a := range fn.arcs[u] {
+				if !visited[a.to] && a.residual() > 0 {
+					visited[a.to] = true
+					from[a.to], through[a.to] = u, a
+					q.Add(a.to)
+				}
+			}
+		}
+
+		if !visited[sink] {
+			return total
+		}
+
+		bottleneck := through[sink].residual()
+		for v := sink; v != source; v = from[v] {
+			bottleneck = minWeight(bottleneck, through[v].residual())
+		}
+
+		for v := sink; v != source; v = from[v] {
+			fn.push(through[v], bottleneck)
+		}
+
+		total += bottleneck
+	}
+}
+
+func (fn *flowNetwork[W]) dinic(source, sink int) W {
+	var total W
+
+	for {
+		levels := fn.levels(source)
+		if levels[sink] == internal.InvalidIndex {
+			return total
+		}
+
+		// no augmenting path can carry more than what leaves the source.
+		var limit W
+		for _, a := range fn.arcs[source] {
+			limit += a.residual()
+		}
+
+		next := make([]int, len(fn.arcs))
+		for {
+			f := fn.augment(source, sink, limit, levels, next)
+			if f == 0 {
+				break
+			}
+
+			total += f
+		}
+	}
+}
+
+func (fn *flowNetwork[W]) levels(source int) []int {
+	res := make([]int, len(fn.arcs))
+	for i := range res {
+		res[i] = internal.InvalidIndex
+	}
+
+	q := queue.NewLinkedQueue[int]()
+	q.Add(source)
+	res[source] = 0
+
+	for !q.Empty() {
+		u, _ := q.Remove()
+
+		for _, a := range fn.arcs[u] {
+			if res[a.to] == internal.InvalidIndex && a.residual() > 0 {
+				res[a.to] = res[u] + 1
+				q.Add(a.to)
+			}
+		}
+	}
+
+	return res
+}
+
+func (fn *flowNetwork[W]) augment(u, sink int, limit W, levels, next []int) W {
+	if u == sink {
+		return limit
+	}
+
+	for ; next[u] < len(fn.arcs[u]); next[u]++ {
+		a := fn.arcs[u][next[u]]
+
+		if a.residual() <= 0 || levels[a.to] != levels[u]+1 {
+			continue
+		}
+
+		if f := fn.augment(a.to, sink, minWeight(limit, a.residual()), levels, next); f > 0 {
+			fn.push(a, f)
+			return f
+		}
+	}
+
+	return 0
+}
+
+func (fn *flowNetwork[W]) reachable(source int) []bool {
+	levels := fn.levels(source)
+
+	res := make([]bool, len(levels))
+	for i, l := range levels {
+		res[i] = l != internal.InvalidIndex
+	}
+
+	return res
+}
+
+type nodePair[T any, W Weight] struct {
+	from, to *Node[T, W]
+}
+
+type FlowResult[T any, W Weight] struct {
+	value      W
+	nodes      []*Node[T, W]
+	sourceSide []bool
+	pairs      []nodePair[T, W]
+	arcs       map[nodePair[T, W]]*flowArc[W]
+}
+
+func (fr *FlowResult[T, W]) Value() W {
+	return fr.value
+}
+
+// Flow returns the flow through the edges from one node to the other,
+// parallel edges are merged into a single edge with the summed capacity.
+func (fr *FlowResult[T, W]) Flow(from, to *Node[T, W]) (W, error) {
+	a, ok := fr.arcs[nodePair[T, W]{from: from, to: to}]
+	if !ok {
+		return internal.Zero, edgeNotFoundError(from.data, to.data, "FlowResult.Flow")
+	}
+
+	return a.flow, nil
+}
+
+func (fr *FlowResult[T, W]) MinCut() (list.List[*Node[T, W]], list.List[*Node[T, W]]) {
+	sourceSide := list.NewArrayList[*Node[T, W]]()
+	sinkSide := list.NewArrayList[*Node[T, W]]()
+
+	for i, n := range fr.nodes {
+		if fr.sourceSide[i] {
+			sourceSide.Add(n)
+		} else {
+			sinkSide.Add(n)
+		}
+	}
+
+	return sourceSide, sinkSide
+}
+
+// CutEdges returns the saturated edges crossing the min cut weighted by their capacity.
+func (fr *FlowResult[T, W]) CutEdges() list.List[*Edge[T, W]] {
+	indexes := make(map[*Node[T, W]]int)
+	for i, n := range fr.nodes {
+		indexes[n] = i
+	}
+
+	res := list.NewArrayList[*Edge[T, W]]()
+
+	for _, p := range fr.pairs {
+		if fr.sourceSide[indexes[p.from]] && !fr.sourceSide[indexes[p.to]] {
+			res.Add(newWeightedDiEdge(p.to, fr.arcs[p].capacity).view(p.from))
+		}
+	}
+
+	return res
+}
+
+// EdmondsKarp returns the maximum flow from source to sink, the weights of the
+// edges of g being their capacities, augmenting along the shortest paths first.
+func EdmondsKarp[T any, W Weight](g Graph[T, W], source, sink *Node[T, W]) (*FlowResult[T, W], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	if err := checkEndpoints(g, source, sink, "EdmondsKarp"); err != nil {
+		return nil, err
+	}
+
+	return maxFlow(g, source, sink, (*flowNetwork[W]).edmondsKarp, "EdmondsKarp")
+}
+
+// Dinic returns the same maximum flow as EdmondsKarp, augmenting along all the
+// shortest paths of a level graph at once.
+func Dinic[T any, W Weight](g Graph[T, W], source, sink *Node[T, W]) (*FlowResult[T, W], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	if err := checkEndpoints(g, source, sink, "Dinic"); err != nil {
+		return nil, err
+	}
+
+	return maxFlow(g, source, sink, (*flowNetwork[W]).dinic, "Dinic")
+}
+
+// BipartiteMatching returns a largest set of edges going out of the nodes of left
+// into the other nodes of g such that no two of them share a node.
+func BipartiteMatching[T any, W Weight](g Graph[T, W], left list.List[*Node[T, W]]) (list.List[*Edge[T, W]], error) {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	if _, err := checkNodes(g, left, "BipartiteMatching"); err != nil {
+		return nil, err
+	}
+
+	return bipartiteMatching(g, left), nil
+}
+
+type flowAlgorithm[W Weight] func(fn *flowNetwork[W], source, sink int) W
+
+func maxFlow[T any, W Weight](g Graph[T, W], source, sink *Node[T, W], algorithm flowAlgorithm[W], operation erx.Operation) (*FlowResult[T, W], error) {
+	if source == sink {
+		return nil, invalidFlowNetworkError(fmt.Errorf("source and sink are the same node %v", source.data), operation)
+	}
+
+	res := &FlowResult[T, W]{
+		nodes: make([]*Node[T, W], 0),
+		pairs: make([]nodePair[T, W], 0),
+		arcs:  make(map[nodePair[T, W]]*flowArc[W]),
+	}
+
+	indexes := make(map[*Node[T, W]]int)
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		indexes[n] = len(res.nodes)
+		res.nodes = append(res.nodes, n)
+	}
+
+	fn := newFlowNetwork[W](len(res.nodes))
+
+	for i, n := range res.nodes {
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+
+			if e.weight < 0 {
+				return nil, invalidFlowNetworkError(fmt.Errorf("edge %v to %v has negative capacity", n.data, e.next.data), operation)
+			}
+
+			// a self loop can never carry flow towards the sink.
+			if e.next == n {
+				continue
+			}
+
+			p := nodePair[T, W]{from: n, to: e.next}
+			if a, ok := res.arcs[p]; ok {
+				a.capacity += e.weight
+				continue
+			}
+
+			res.pairs = append(res.pairs, p)
+			res.arcs[p] = fn.addArc(i, indexes[e.next], e.weight)
+		}
+	}
+
+	res.value = algorithm(fn, indexes[source], indexes[sink])
+	res.sourceSide = fn.reachable(indexes[source])
+
+	return res, nil
+}
+
+// bipartiteMatching joins a virtual source to every node of left and every node
+// reachable by a single edge from left to a virtual sink, each with capacity one.
+func bipartiteMatching[T any, W Weight](g Graph[T, W], left list.List[*Node[T, W]]) list.List[*Edge[T, W]] {
+	nodes := make([]*Node[T, W], 0)
+	indexes := make(map[*Node[T, W]]int)
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		indexes[n] = len(nodes)
+		nodes = append(nodes, n)
+	}
+
+	isLeft := make([]bool, len(nodes))
+
+	li := left.Iterator()
+	for li.HasNext() {
+		n, _ := li.Next()
+		isLeft[indexes[n]] = true
+	}
+
+	source, sink := len(nodes), len(nodes)+1
+	fn := newFlowNetwork[W](len(nodes) + 2)
+
+	type candidate struct {
+		from *Node[T, W]
+		edge *edge[T, W]
+		arc  *flowArc[W]
+	}
+
+	candidates := make([]candidate, 0)
+	joined := make(map[nodePair[T, W]]bool)
+	toSink := make([]bool, len(nodes))
+
+	for i, n := range nodes {
+		if !isLeft[i] {
+			continue
+		}
+
+		fn.addArc(source, i, 1)
+
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+
+			j := indexes[e.next]
+			if isLeft[j] || joined[nodePair[T, W]{from: n, to: e.next}] {
+				continue
+			}
+
+			joined[nodePair[T, W]{from: n, to: e.next}] = true
+			candidates = append(candidates, candidate{from: n, edge: e, arc: fn.addArc(i, j, 1)})
+
+			if !toSink[j] {
+				toSink[j] = true
+				fn.addArc(j, sink, 1)
+			}
+		}
+	}
+
+	fn.dinic(source, sink)
+
+	res := list.NewArrayList[*Edge[T, W]]()
+
+	for _, c := range candidates {
+		if c.arc.flow > 0 {
+			res.Add(c.edge.view(c.from))
+		}
+	}
+
+	return res
+}
+
+func minWeight[W Weight](a, b W) W {
+	if a < b {
+		return a
+	}
+
+	return b
+}
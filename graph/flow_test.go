@@ -0,0 +1,220 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListGraphMaxFlow(t *testing.T) {
+	newNetwork := func() (Graph[int, int64], []*Node[int, int64]) {
+		g := NewListGraph[int]()
+
+		nodes := make([]*Node[int, int64], 6)
+		for i := range nodes {
+			nodes[i] = NewNode[int](i)
+		}
+
+		createWeightedEdge(g, false, nodes[0], nodes[1], 16)
+		createWeightedEdge(g, false, nodes[0], nodes[2], 13)
+		createWeightedEdge(g, false, nodes[1], nodes[3], 12)
+		createWeightedEdge(g, false, nodes[2], nodes[1], 4)
+		createWeightedEdge(g, false, nodes[2], nodes[4], 14)
+		createWeightedEdge(g, false, nodes[3], nodes[2], 9)
+		createWeightedEdge(g, false, nodes[3], nodes[5], 20)
+		createWeightedEdge(g, false, nodes[4], nodes[3], 7)
+		createWeightedEdge(g, false, nodes[4], nodes[5], 4)
+
+		return g, nodes
+	}
+
+	toData := func(l list.List[*Node[int, int64]]) []int {
+		res := make([]int, 0)
+
+		it := l.Iterator()
+		for it.HasNext() {
+			n, _ := it.Next()
+			res = append(res, n.data)
+		}
+
+		return res
+	}
+
+	for algorithm, maxFlow := range map[string]func(Graph[int, int64], *Node[int, int64], *Node[int, int64]) (*FlowResult[int, int64], error){
+		"edmonds karp": EdmondsKarp[int, int64],
+		"dinic":        Dinic[int, int64],
+	} {
+		t.Run(algorithm, func(t *testing.T) {
+			g, nodes := newNetwork()
+
+			res, err := maxFlow(g, nodes[0], nodes[5])
+			require.NoError(t, err)
+			assert.Equal(t, int64(23), res.Value())
+
+			balance := make(map[*Node[int, int64]]int64)
+
+			it := g.Edges()
+			for it.HasNext() {
+				e, _ := it.Next()
+
+				f, err := res.Flow(e.From(), e.To())
+				require.NoError(t, err)
+				assert.GreaterOrEqual(t, f, int64(0))
+				assert.LessOrEqual(t, f, e.Weight())
+
+				balance[e.From()] -= f
+				balance[e.To()] += f
+			}
+
+			for _, n := range nodes[1:5] {
+				assert.Equal(t, int64(0), balance[n])
+			}
+
+			assert.Equal(t, int64(-23), balance[nodes[0]])
+			assert.Equal(t, int64(23), balance[nodes[5]])
+
+			sourceSide, sinkSide := res.MinCut()
+			assert.Equal(t, []int{0, 1, 2, 4}, toData(sourceSide))
+			assert.Equal(t, []int{3, 5}, toData(sinkSide))
+
+			var capacity int64
+
+			ci := res.CutEdges().Iterator()
+			for ci.HasNext() {
+				e, _ := ci.Next()
+				capacity += e.Weight()
+			}
+
+			assert.Equal(t, res.Value(), capacity)
+			assert.Equal(t, int64(3), res.CutEdges().Size())
+
+			_, err = res.Flow(nodes[5], nodes[0])
+			internal.AssertErrorEquals(t, errors.New("edge 5 to 0 not found in the graph"), err)
+		})
+	}
+}
+
+func TestListGraphMaxFlowOnGrid(t *testing.T) {
+	g := graphGrid(5, 5, 7, 12, 17)
+
+	source := getNodeWithVal(g, 0)
+	sink := getNodeWithVal(g, 24)
+
+	ek, err := EdmondsKarp(g, source, sink)
+	require.NoError(t, err)
+
+	dn, err := Dinic(g, source, sink)
+	require.NoError(t, err)
+
+	assert.Equal(t, ek.Value(), dn.Value())
+	assert.Greater(t, ek.Value(), int64(0))
+
+	for _, res := range []*FlowResult[int, int64]{ek, dn} {
+		var capacity int64
+
+		it := res.CutEdges().Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			capacity += e.Weight()
+		}
+
+		assert.Equal(t, res.Value(), capacity)
+	}
+}
+
+func TestListGraphMaxFlowFailure(t *testing.T) {
+	g, _ := graphTwentyThree()
+
+	zero := getNodeWithVal(g, 0)
+	four := getNodeWithVal(g, 4)
+
+	_, err := Dinic(g, zero, four)
+	internal.AssertErrorEquals(t, errors.New("invalid flow network: edge 3 to 1 has negative capacity"), err)
+
+	_, err = EdmondsKarp(g, zero, zero)
+	internal.AssertErrorEquals(t, errors.New("invalid flow network: source and sink are the same node 0"), err)
+
+	_, err = EdmondsKarp(g, zero, NewNode[int](9))
+	internal.AssertErrorEquals(t, errors.New("node 9 not found in the graph"), err)
+}
+
+func TestListGraphBipartiteMatching(t *testing.T) {
+	testCases := map[string]struct {
+		edges         map[int][]int
+		left          []int
+		expectedCount int64
+	}{
+		"should match every node when a perfect matching exists": {
+			edges:         map[int][]int{1: {4, 5}, 2: {4}, 3: {5, 6}},
+			left:          []int{1, 2, 3},
+			expectedCount: 3,
+		},
+		"should return maximum matching when nodes compete": {
+			edges:         map[int][]int{1: {4, 5}, 2: {4}, 3: {4}},
+			left:          []int{1, 2, 3},
+			expectedCount: 2,
+		},
+		"should ignore edges between left nodes": {
+			edges:         map[int][]int{1: {2, 4}, 2: {1}},
+			left:          []int{1, 2},
+			expectedCount: 1,
+		},
+		"should return empty matching when left has no edges": {
+			edges:         map[int][]int{1: {}, 4: {}},
+			left:          []int{1},
+			expectedCount: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			g := NewListGraph[int]()
+
+			nodes := make(map[int]*Node[int, int64])
+			nodeOf := func(data int) *Node[int, int64] {
+				if _, ok := nodes[data]; !ok {
+					nodes[data] = NewNode[int](data)
+					g.AddNode(nodes[data])
+				}
+				return nodes[data]
+			}
+
+			for from, targets := range testCase.edges {
+				for _, to := range targets {
+					createEdge(g, true, nodeOf(from), nodeOf(to))
+				}
+				nodeOf(from)
+			}
+
+			left := list.NewArrayList[*Node[int, int64]]()
+			for _, data := range testCase.left {
+				left.Add(nodeOf(data))
+			}
+
+			res, err := BipartiteMatching[int, int64](g, left)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCount, res.Size())
+
+			used := make(map[*Node[int, int64]]bool)
+
+			it := res.Iterator()
+			for it.HasNext() {
+				e, _ := it.Next()
+
+				assert.True(t, left.Contains(e.From()))
+				assert.False(t, left.Contains(e.To()))
+				assert.False(t, used[e.From()])
+				assert.False(t, used[e.To()])
+
+				used[e.From()] = true
+				used[e.To()] = true
+			}
+		})
+	}
+
+	_, err := BipartiteMatching[int, int64](NewListGraph[int](), list.NewArrayList[*Node[int, int64]](NewNode[int](1)))
+	internal.AssertErrorEquals(t, errors.New("node 1 not found in the graph"), err)
+}
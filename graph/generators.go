@@ -0,0 +1,253 @@
+package graph
+
+import (
+	"github.com/nsnikhil/erx"
+	"math/rand"
+)
+
+// Generator builds random graphs for tests and benchmarks. Nodes hold the numbers
+// from zero up to the number of nodes and are returned in that order, so the
+// same source and seed always give the same graph.
+type Generator[W Weight] struct {
+	rnd      *rand.Rand
+	newGraph func() Graph[int, W]
+	weight   func(r *rand.Rand) W
+}
+
+// NewGenerator returns a generator drawing from source, newGraph builds the empty
+// graphs to fill and weight picks the weight of every edge, a nil weight leaves
+// all of them at zero.
+func NewGenerator[W Weight](source rand.Source, newGraph func() Graph[int, W], weight func(r *rand.Rand) W) *Generator[W] {
+	return &Generator[W]{
+		rnd:      rand.New(source),
+		newGraph: newGraph,
+		weight:   weight,
+	}
+}
+
+func (gn *Generator[W]) nodes(n int) (Graph[int, W], []*Node[int, W]) {
+	g := gn.newGraph()
+	nodes := make([]*Node[int, W], n)
+
+	for i := range nodes {
+		nodes[i] = NewWeightedNode[int, W](i)
+		g.AddNode(nodes[i])
+	}
+
+	return g, nodes
+}
+
+func (gn *Generator[W]) link(g Graph[int, W], a, b *Node[int, W], directed bool) {
+	var w W
+	if gn.weight != nil {
+		w = gn.weight(gn.rnd)
+	}
+
+	if directed {
+		_ = g.CreateWeightedDiEdge(a, b, w)
+		return
+	}
+
+	_ = g.CreateWeightedBiEdge(a, b, w)
+}
+
+func checkNodeCount(n int, operation erx.Operation) error {
+	if n < 0 {
+		return invalidParameterError("node count", n, operation)
+	}
+
+	return nil
+}
+
+func checkProbability(p float64, operation erx.Operation) error {
+	if p < 0 || p > 1 {
+		return invalidParameterError("probability", p, operation)
+	}
+
+	return nil
+}
+
+// ErdosRenyi links every pair of distinct nodes with probability p, directed graphs
+// draw both ways of a pair separately.
+func (gn *Generator[W]) ErdosRenyi(n int, p float64, directed bool) (Graph[int, W], []*Node[int, W], error) {
+	if err := checkNodeCount(n, "Generator.ErdosRenyi"); err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkProbability(p, "Generator.ErdosRenyi"); err != nil {
+		return nil, nil, err
+	}
+
+	g, nodes := gn.nodes(n)
+
+	for i := range nodes {
+		for j := range nodes {
+			if i == j || (!directed && j < i) {
+				continue
+			}
+
+			if gn.rnd.Float64() < p {
+				gn.link(g, nodes[i], nodes[j], directed)
+			}
+		}
+	}
+
+	return g, nodes, nil
+}
+
+// BarabasiAlbert starts from m+1 nodes all linked together and links every other
+// node to m distinct earlier ones, picked with a probability proportional to
+// their degree. The graph is undirected.
+func (gn *Generator[W]) BarabasiAlbert(n, m int) (Graph[int, W], []*Node[int, W], error) {
+	if m < 1 || m >= n {
+		return nil, nil, invalidParameterError("edges per node", m, "Generator.BarabasiAlbert")
+	}
+
+	g, nodes := gn.nodes(n)
+
+	// ends holds both ends of every edge, so picking from it uniformly picks a
+	// node in proportion to its degree.
+	ends := make([]int, 0, 2*m*n)
+
+	for i := 0; i <= m; i++ {
+		for j := i + 1; j <= m; j++ {
+			gn.link(g, nodes[i], nodes[j], false)
+			ends = append(ends, i, j)
+		}
+	}
+
+	for i := m + 1; i < n; i++ {
+		targets := make(map[int]bool)
+		order := make([]int, 0, m)
+
+		for len(order) < m {
+			j := ends[gn.rnd.Intn(len(ends))]
+			if !targets[j] {
+				targets[j] = true
+				order = append(order, j)
+			}
+		}
+
+		for _, j := range order {
+			gn.link(g, nodes[i], nodes[j], false)
+			ends = append(ends, i, j)
+		}
+	}
+
+	return g, nodes, nil
+}
+
+// Grid links every cell of a rows by cols lattice to the cells next to it, the
+// node of the cell at row r and column c holds r*cols+c. The graph is undirected.
+func (gn *Generator[W]) Grid(rows, cols int) (Graph[int, W], []*Node[int, W], error) {
+	if rows < 0 {
+		return nil, nil, invalidParameterError("rows", rows, "Generator.Grid")
+	}
+
+	if cols < 0 {
+		return nil, nil, invalidParameterError("cols", cols, "Generator.Grid")
+	}
+
+	g, nodes := gn.nodes(rows * cols)
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if c+1 < cols {
+				gn.link(g, nodes[r*cols+c], nodes[r*cols+c+1], false)
+			}
+
+			if r+1 < rows {
+				gn.link(g, nodes[r*cols+c], nodes[(r+1)*cols+c], false)
+			}
+		}
+	}
+
+	return g, nodes, nil
+}
+
+// Complete links every pair of distinct nodes.
+func (gn *Generator[W]) Complete(n int, directed bool) (Graph[int, W], []*Node[int, W], error) {
+	return gn.ErdosRenyi(n, 1, directed)
+}
+
+// RandomDAG orders the nodes at random and adds an edge from every node to every
+// later one with probability p, so that no edge can close a cycle.
+func (gn *Generator[W]) RandomDAG(n int, p float64) (Graph[int, W], []*Node[int, W], error) {
+	if err := checkNodeCount(n, "Generator.RandomDAG"); err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkProbability(p, "Generator.RandomDAG"); err != nil {
+		return nil, nil, err
+	}
+
+	g, nodes := gn.nodes(n)
+	order := gn.rnd.Perm(n)
+
+	for i := range order {
+		for j := i + 1; j < n; j++ {
+			if gn.rnd.Float64() < p {
+				gn.link(g, nodes[order[i]], nodes[order[j]], true)
+			}
+		}
+	}
+
+	return g, nodes, nil
+}
+
+// RandomTree picks a tree uniformly among all the trees over n nodes by decoding a
+// random prufer sequence. The graph is undirected.
+func (gn *Generator[W]) RandomTree(n int) (Graph[int, W], []*Node[int, W], error) {
+	if err := checkNodeCount(n, "Generator.RandomTree"); err != nil {
+		return nil, nil, err
+	}
+
+	g, nodes := gn.nodes(n)
+	if n < 2 {
+		return g, nodes, nil
+	}
+
+	sequence := make([]int, n-2)
+	degree := make([]int, n)
+
+	for i := range degree {
+		degree[i] = 1
+	}
+
+	for i := range sequence {
+		sequence[i] = gn.rnd.Intn(n)
+		degree[sequence[i]]++
+	}
+
+	// every step links the smallest leaf left to the next node of the sequence,
+	// ptr only moves forward unless that node just became a smaller leaf.
+	ptr := 0
+	for degree[ptr] != 1 {
+		ptr++
+	}
+
+	leaf := ptr
+
+	for _, v := range sequence {
+		gn.link(g, nodes[leaf], nodes[v], false)
+
+		degree[leaf]--
+		degree[v]--
+
+		if degree[v] == 1 && v < ptr {
+			leaf = v
+			continue
+		}
+
+		ptr++
+		for degree[ptr] != 1 {
+			ptr++
+		}
+
+		leaf = ptr
+	}
+
+	gn.link(g, nodes[leaf], nodes[n-1], false)
+
+	return g, nodes, nil
+}
@@ -0,0 +1,250 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"testing"
+)
+
+var graphKinds = map[string]func() Graph[int, int64]{
+	"list":   NewListGraph[int],
+	"matrix": NewMatrixGraph[int],
+}
+
+func randomWeight(r *rand.Rand) int64 {
+	return r.Int63n(10) + 1
+}
+
+func countEdges(g Graph[int, int64]) int {
+	res := 0
+
+	it := g.Edges()
+	for it.HasNext() {
+		_, _ = it.Next()
+		res++
+	}
+
+	return res
+}
+
+func TestGenerators(t *testing.T) {
+	testCases := map[string]struct {
+		generate      func(gn *Generator[int64]) (Graph[int, int64], []*Node[int, int64], error)
+		expectedNodes int
+		expectedEdges int
+	}{
+		"test erdos renyi without edges": {
+			generate: func(gn *Generator[int64]) (Graph[int, int64], []*Node[int, int64], error) {
+				return gn.ErdosRenyi(6, 0, true)
+			},
+			expectedNodes: 6,
+			expectedEdges: 0,
+		},
+		"test complete directed": {
+			generate: func(gn *Generator[int64]) (Graph[int, int64], []*Node[int, int64], error) {
+				return gn.Complete(5, true)
+			},
+			expectedNodes: 5,
+			expectedEdges: 20,
+		},
+		"test complete undirected": {
+			generate: func(gn *Generator[int64]) (Graph[int, int64], []*Node[int, int64], error) {
+				return gn.Complete(5, false)
+			},
+			expectedNodes: 5,
+			expectedEdges: 20,
+		},
+		"test barabasi albert": {
+			generate: func(gn *Generator[int64]) (Graph[int, int64], []*Node[int, int64], error) {
+				return gn.BarabasiAlbert(20, 2)
+			},
+			expectedNodes: 20,
+			expectedEdges: 2 * (3 + 17*2),
+		},
+		"test grid": {
+			generate: func(gn *Generator[int64]) (Graph[int, int64], []*Node[int, int64], error) {
+				return gn.Grid(3, 4)
+			},
+			expectedNodes: 12,
+			expectedEdges: 2 * (3*3 + 2*4),
+		},
+		"test random tree": {
+			generate: func(gn *Generator[int64]) (Graph[int, int64], []*Node[int, int64], error) {
+				return gn.RandomTree(30)
+			},
+			expectedNodes: 30,
+			expectedEdges: 2 * 29,
+		},
+		"test random tree with one node": {
+			generate: func(gn *Generator[int64]) (Graph[int, int64], []*Node[int, int64], error) {
+				return gn.RandomTree(1)
+			},
+			expectedNodes: 1,
+			expectedEdges: 0,
+		},
+		"test random dag with every edge": {
+			generate: func(gn *Generator[int64]) (Graph[int, int64], []*Node[int, int64], error) {
+				return gn.RandomDAG(6, 1)
+			},
+			expectedNodes: 6,
+			expectedEdges: 15,
+		},
+	}
+
+	for kind, newGraph := range graphKinds {
+		for name, testCase := range testCases {
+			t.Run(kind+" "+name, func(t *testing.T) {
+				g, nodes, err := testCase.generate(NewGenerator[int64](rand.NewSource(1), newGraph, randomWeight))
+				require.NoError(t, err)
+
+				assert.Len(t, nodes, testCase.expectedNodes)
+				assert.Len(t, nodeData(g), testCase.expectedNodes)
+				assert.Equal(t, testCase.expectedEdges, countEdges(g))
+
+				for i, n := range nodes {
+					assert.Equal(t, i, n.Data())
+					assert.True(t, g.Contains(n))
+				}
+			})
+		}
+	}
+}
+
+func TestGeneratorsAreSeedable(t *testing.T) {
+	generate := func(seed int64) map[string]int64 {
+		g, _, err := NewGenerator[int64](rand.NewSource(seed), NewListGraph[int], randomWeight).ErdosRenyi(15, 0.3, true)
+		require.NoError(t, err)
+		return labeledWeights(g)
+	}
+
+	assert.Equal(t, generate(3), generate(3))
+	assert.NotEqual(t, generate(3), generate(4))
+}
+
+func TestGeneratorsShape(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		gn := NewGenerator[int64](rand.NewSource(seed), NewListGraph[int], nil)
+
+		tree, _, err := gn.RandomTree(25)
+		require.NoError(t, err)
+		require.Len(t, tree.GetConnectedComponents(), 1)
+		assert.False(t, tree.HasLoop())
+
+		dag, _, err := gn.RandomDAG(25, 0.3)
+		require.NoError(t, err)
+		assert.False(t, dag.HasCycle())
+
+		ba, nodes, err := gn.BarabasiAlbert(25, 3)
+		require.NoError(t, err)
+		require.Len(t, ba.GetConnectedComponents(), 1)
+
+		for _, n := range nodes[4:] {
+			assert.GreaterOrEqual(t, first(ba.OutDegreeOfNode(n)), int64(3))
+		}
+	}
+}
+
+// shortest paths picked by properties must weigh as much as the ones found by
+// bellman ford, which assumes nothing about the graph.
+func TestShortestPathOnRandomGraphs(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		gn := NewGenerator[int64](rand.NewSource(seed), NewListGraph[int], randomWeight)
+
+		random, randomNodes, err := gn.ErdosRenyi(20, 0.15, true)
+		require.NoError(t, err)
+
+		dag, dagNodes, err := gn.RandomDAG(20, 0.3)
+		require.NoError(t, err)
+
+		checks := []struct {
+			g          Graph[int, int64]
+			nodes      []*Node[int, int64]
+			properties []Property
+		}{
+			{g: random, nodes: randomNodes, properties: []Property{NonNegativeWeights}},
+			{g: dag, nodes: dagNodes, properties: []Property{Directed, ACyclic}},
+		}
+
+		for _, c := range checks {
+			source, target := c.nodes[0], c.nodes[len(c.nodes)-1]
+
+			expected, expectedErr := c.g.ShortestPath(source, target)
+			actual, err := c.g.ShortestPath(source, target, c.properties...)
+
+			if expectedErr != nil {
+				assert.Error(t, err)
+				continue
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, first(pathWeight(c.g, expected)), first(pathWeight(c.g, actual)))
+		}
+	}
+}
+
+func TestGeneratorsFailure(t *testing.T) {
+	gn := NewGenerator[int64](rand.NewSource(1), NewListGraph[int], nil)
+
+	testCases := map[string]struct {
+		generate      func() error
+		expectedError error
+	}{
+		"test erdos renyi with negative node count": {
+			generate:      func() error { _, _, err := gn.ErdosRenyi(-1, 0.5, true); return err },
+			expectedError: errors.New("invalid node count -1"),
+		},
+		"test erdos renyi with invalid probability": {
+			generate:      func() error { _, _, err := gn.ErdosRenyi(5, 1.5, true); return err },
+			expectedError: errors.New("invalid probability 1.5"),
+		},
+		"test barabasi albert with too many edges per node": {
+			generate:      func() error { _, _, err := gn.BarabasiAlbert(3, 3); return err },
+			expectedError: errors.New("invalid edges per node 3"),
+		},
+		"test grid with negative rows": {
+			generate:      func() error { _, _, err := gn.Grid(-2, 3); return err },
+			expectedError: errors.New("invalid rows -2"),
+		},
+		"test random dag with invalid probability": {
+			generate:      func() error { _, _, err := gn.RandomDAG(5, -0.5); return err },
+			expectedError: errors.New("invalid probability -0.5"),
+		},
+		"test random tree with negative node count": {
+			generate:      func() error { _, _, err := gn.RandomTree(-3); return err },
+			expectedError: errors.New("invalid node count -3"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			internal.AssertErrorEquals(t, testCase.expectedError, testCase.generate())
+		})
+	}
+}
+
+func BenchmarkShortestPath(b *testing.B) {
+	for kind, newGraph := range graphKinds {
+		g, nodes, _ := NewGenerator[int64](rand.NewSource(1), newGraph, randomWeight).Grid(30, 30)
+
+		b.Run(kind, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = g.ShortestPath(nodes[0], nodes[len(nodes)-1], NonNegativeWeights)
+			}
+		})
+	}
+}
+
+func BenchmarkGetConnectedComponents(b *testing.B) {
+	for kind, newGraph := range graphKinds {
+		g, _, _ := NewGenerator[int64](rand.NewSource(1), newGraph, nil).ErdosRenyi(500, 0.002, false)
+
+		b.Run(kind, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = g.GetConnectedComponents()
+			}
+		})
+	}
+}
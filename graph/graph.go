@@ -5,46 +5,87 @@ import (
 	"github.com/nsnikhil/go-datastructures/list"
 )
 
-type Graph[T any] interface {
-	AddNode(n *Node[T])
+type Graph[T any, W Weight] interface {
+	AddNode(n *Node[T, W])
 
-	CreateDiEdge(curr *Node[T], next *Node[T]) error
+	CreateDiEdge(curr *Node[T, W], next *Node[T, W]) error
 
-	CreateWeightedDiEdge(curr, next *Node[T], weight int64) error
+	CreateWeightedDiEdge(curr, next *Node[T, W], weight W) error
 
-	CreateBiEdge(curr *Node[T], next *Node[T]) error
+	CreateBiEdge(curr *Node[T, W], next *Node[T, W]) error
 
-	CreateWeightedBiEdge(curr, nodes *Node[T], weight int64) error
+	CreateWeightedBiEdge(curr, nodes *Node[T, W], weight W) error
 
-	DeleteNode(n *Node[T]) error
+	CreateLabeledDiEdge(curr, next *Node[T, W], label string, weight W) error
 
-	DeleteEdge(start, end *Node[T]) error
+	CreateLabeledBiEdge(curr, next *Node[T, W], label string, weight W) error
 
-	Contains(n *Node[T]) bool
+	DeleteNode(n *Node[T, W]) error
+
+	DeleteEdge(start, end *Node[T, W]) error
+
+	DeleteLabeledEdge(start, end *Node[T, W], label string) error
+
+	Contains(n *Node[T, W]) bool
+
+	Nodes() iterator.Iterator[*Node[T, W]]
+	Edges() iterator.Iterator[*Edge[T, W]]
 
 	//print()
-	DFSIterator() iterator.Iterator[*Node[T]]
-	BFSIterator() iterator.Iterator[*Node[T]]
+	DFSIterator() iterator.Iterator[*Node[T, W]]
+	BFSIterator() iterator.Iterator[*Node[T, W]]
+
+	ParallelBFS(source *Node[T, W], workers int) ([]list.List[*Node[T, W]], error)
 
 	HasLoop() bool
 	HasCycle() bool
 	//HasBridge() bool
 
-	AreAdjacent(a, b *Node[T]) (bool, error)
+	AreAdjacent(a, b *Node[T, W]) (bool, error)
+	EdgeWeight(a, b *Node[T, W]) (W, error)
+	FindEdge(a, b *Node[T, W], label string) (*Edge[T, W], error)
+	EdgesBetween(a, b *Node[T, W]) (list.List[*Edge[T, W]], error)
 
-	InDegreeOfNode(a *Node[T]) (int64, error)
-	OutDegreeOfNode(a *Node[T]) (int64, error)
+	InDegreeOfNode(a *Node[T, W]) (int64, error)
+	OutDegreeOfNode(a *Node[T, W]) (int64, error)
 
 	Reverse()
-	Clone() Graph[T]
+	Clone() Graph[T, W]
+
+	Subgraph(nodes list.List[*Node[T, W]]) (Graph[T, W], error)
+	EdgeSubgraph(edges list.List[*Edge[T, W]]) (Graph[T, W], error)
+	Neighborhood(n *Node[T, W], k int) (Graph[T, W], error)
+	Contract(nodes list.List[*Node[T, W]], data T) (Graph[T, W], error)
+	Complement() Graph[T, W]
+	Transpose() Graph[T, W]
 
-	HasRoute(source, target *Node[T]) (bool, error)
+	HasRoute(source, target *Node[T, W]) (bool, error)
 
 	//IsDirected() bool
 
 	//IsConnected() bool
 
-	GetConnectedComponents() []list.List[*Node[T]]
+	GetConnectedComponents() []list.List[*Node[T, W]]
+	StronglyConnectedComponents() []list.List[*Node[T, W]]
+	WeaklyConnectedComponents() []list.List[*Node[T, W]]
+
+	Bridges() (list.List[*Edge[T, W]], error)
+	ArticulationPoints() (list.List[*Node[T, W]], error)
+	BiconnectedComponents() ([]list.List[*Node[T, W]], error)
+
+	ShortestPath(source, target *Node[T, W], properties ...Property) (list.List[*Node[T, W]], error)
+	ShortestPathTree(source *Node[T, W], properties ...Property) (*ShortestPathTree[T, W], error)
+
+	FloydWarshall() (*AllPairsShortestPaths[T, W], error)
+	Johnson() (*AllPairsShortestPaths[T, W], error)
+
+	AStar(source, target *Node[T, W], heuristic Heuristic[T, W]) (*PathResult[T, W], error)
+	BidirectionalDijkstra(source, target *Node[T, W]) (*PathResult[T, W], error)
+	BidirectionalBFS(source, target *Node[T, W]) (*PathResult[T, W], error)
+
+	KruskalMST() (Graph[T, W], W, error)
+	PrimMST() (Graph[T, W], W, error)
 
-	ShortestPath(source, target *Node[T], properties ...Property) (list.List[*Node[T]], error)
+	TopologicalSort() (list.List[*Node[T, W]], error)
+	TopologicalLayers() ([]list.List[*Node[T, W]], error)
 }
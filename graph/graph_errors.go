@@ -37,3 +37,163 @@ var pathNotFoundError = func(from, to interface{}, operation erx.Operation) *erx
 		fmt.Errorf("path %v to %v not found in the graph", from, to),
 	)
 }
+
+var cycleFoundError = func(nodes interface{}, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("cycleFoundError"),
+		operation,
+		fmt.Errorf("graph has a cycle through nodes %v", nodes),
+	)
+}
+
+var negativeCycleError = func(operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("negativeCycleError"),
+		operation,
+		errors.New("graph has negative weight cycle"),
+	)
+}
+
+var undirectedGraphRequiredError = func(operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("undirectedGraphRequiredError"),
+		operation,
+		errors.New("graph is not undirected"),
+	)
+}
+
+var invalidFlowNetworkError = func(err error, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("invalidFlowNetworkError"),
+		operation,
+		fmt.Errorf("invalid flow network: %w", err),
+	)
+}
+
+var cycleNotFoundError = func(kind string, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("cycleNotFoundError"),
+		operation,
+		fmt.Errorf("%s not found in the graph", kind),
+	)
+}
+
+var graphTooLargeError = func(size int, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("graphTooLargeError"),
+		operation,
+		fmt.Errorf("graph of size %d is too large", size),
+	)
+}
+
+var invalidGraphFormatError = func(err error, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("invalidGraphFormatError"),
+		operation,
+		fmt.Errorf("invalid graph format: %w", err),
+	)
+}
+
+var graphWriteError = func(err error, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("graphWriteError"),
+		operation,
+		fmt.Errorf("failed to write graph: %w", err),
+	)
+}
+
+var labeledEdgeNotFoundError = func(from, to interface{}, label string, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("labeledEdgeNotFoundError"),
+		operation,
+		fmt.Errorf("edge %v to %v labeled %q not found in the graph", from, to, label),
+	)
+}
+
+var emptyNodeListError = func(operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("emptyNodeListError"),
+		operation,
+		errors.New("node list is empty"),
+	)
+}
+
+var negativeHopCountError = func(k int, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("negativeHopCountError"),
+		operation,
+		fmt.Errorf("hop count %d is negative", k),
+	)
+}
+
+var notBipartiteError = func(operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("notBipartiteError"),
+		operation,
+		errors.New("graph is not bipartite"),
+	)
+}
+
+var loopFoundError = func(node interface{}, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("loopFoundError"),
+		operation,
+		fmt.Errorf("node %v has a loop", node),
+	)
+}
+
+var invalidParameterError = func(name string, value interface{}, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("invalidParameterError"),
+		operation,
+		fmt.Errorf("invalid %s %v", name, value),
+	)
+}
+
+var notConvergedError = func(algorithm string, iterations int, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("notConvergedError"),
+		operation,
+		fmt.Errorf("%s did not converge after %d iterations", algorithm, iterations),
+	)
+}
+
+var walkCancelledError = func(err error, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("walkCancelledError"),
+		operation,
+		fmt.Errorf("walk cancelled: %w", err),
+	)
+}
+
+var searchLimitError = func(limit int, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("searchLimitError"),
+		operation,
+		fmt.Errorf("search reached more than %d states", limit),
+	)
+}
+
+var goalNotFoundError = func(source interface{}, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("goalNotFoundError"),
+		operation,
+		fmt.Errorf("no goal state reachable from %v", source),
+	)
+}
+
+var notIsomorphicError = func(operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("notIsomorphicError"),
+		operation,
+		errors.New("graphs are not isomorphic"),
+	)
+}
+
+var labeledEdgeExistsError = func(from, to interface{}, label string, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("labeledEdgeExistsError"),
+		operation,
+		fmt.Errorf("edge %v to %v labeled %q already exists in the graph", from, to, label),
+	)
+}
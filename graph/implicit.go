@@ -0,0 +1,201 @@
+package graph
+
+import (
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/nsnikhil/go-datastructures/queue"
+)
+
+// Neighbor is a state one step away from another one and the cost of that step.
+type Neighbor[S comparable, W Weight] struct {
+	State  S
+	Weight W
+}
+
+// ImplicitGraph is a graph whose nodes are states generated while it is searched,
+// like the positions of a puzzle, so that it never has to be built up front. States
+// are told apart by value.
+type ImplicitGraph[S comparable, W Weight] interface {
+	Neighbors(state S) []Neighbor[S, W]
+}
+
+// NeighborFunc turns a function into an ImplicitGraph.
+type NeighborFunc[S comparable, W Weight] func(state S) []Neighbor[S, W]
+
+func (nf NeighborFunc[S, W]) Neighbors(state S) []Neighbor[S, W] {
+	return nf(state)
+}
+
+type StatePath[S comparable, W Weight] struct {
+	states list.List[S]
+	weight W
+}
+
+func (sp *StatePath[S, W]) States() list.List[S] {
+	return sp.states
+}
+
+func (sp *StatePath[S, W]) Weight() W {
+	return sp.weight
+}
+
+// stateSearch holds the states reached so far, the search fails once more than
+// maxVisited of them are reached unless maxVisited is zero.
+type stateSearch[S comparable, W Weight] struct {
+	source       S
+	costs        map[S]W
+	predecessors map[S]S
+	maxVisited   int
+	operation    erx.Operation
+}
+
+func newStateSearch[S comparable, W Weight](source S, maxVisited int, operation erx.Operation) (*stateSearch[S, W], error) {
+	if maxVisited < 0 {
+		return nil, invalidParameterError("maxVisited", maxVisited, operation)
+	}
+
+	return &stateSearch[S, W]{
+		source:       source,
+		costs:        map[S]W{source: 0},
+		predecessors: make(map[S]S),
+		maxVisited:   maxVisited,
+		operation:    operation,
+	}, nil
+}
+
+func (ss *stateSearch[S, W]) reach(from S, n Neighbor[S, W]) error {
+	ss.costs[n.State] = ss.costs[from] + n.Weight
+	ss.predecessors[n.State] = from
+
+	if ss.maxVisited > 0 && len(ss.costs) > ss.maxVisited {
+		return searchLimitError(ss.maxVisited, ss.operation)
+	}
+
+	return nil
+}
+
+func (ss *stateSearch[S, W]) pathTo(goal S) *StatePath[S, W] {
+	res := list.NewLinkedList[S]()
+
+	for s := goal; ; s = ss.predecessors[s] {
+		res.AddFirst(s)
+
+		if s == ss.source {
+			break
+		}
+	}
+
+	return &StatePath[S, W]{states: res, weight: ss.costs[goal]}
+}
+
+// ImplicitBFS returns the path to the goal state with the fewest steps from
+// source, its weight is the sum of the weights of those steps.
+func ImplicitBFS[S comparable, W Weight](g ImplicitGraph[S, W], source S, goal func(state S) bool, maxVisited int) (*StatePath[S, W], error) {
+	ss, err := newStateSearch[S, W](source, maxVisited, "ImplicitBFS")
+	if err != nil {
+		return nil, err
+	}
+
+	if goal(source) {
+		return ss.pathTo(source), nil
+	}
+
+	q := []S{source}
+
+	for len(q) > 0 {
+		curr := q[0]
+		q = q[1:]
+
+		for _, n := range g.Neighbors(curr) {
+			if _, ok := ss.costs[n.State]; ok {
+				continue
+			}
+
+			if err := ss.reach(curr, n); err != nil {
+				return nil, err
+			}
+
+			if goal(n.State) {
+				return ss.pathTo(n.State), nil
+			}
+
+			q = append(q, n.State)
+		}
+	}
+
+	return nil, goalNotFoundError(source, "ImplicitBFS")
+}
+
+// ImplicitDijkstra returns the cheapest path from source to a goal state, the
+// weights of the steps must not be negative and the search fails on the first
+// negative one it meets.
+func ImplicitDijkstra[S comparable, W Weight](g ImplicitGraph[S, W], source S, goal func(state S) bool, maxVisited int) (*StatePath[S, W], error) {
+	return implicitAStar(g, source, goal, func(S) W { return 0 }, maxVisited, "ImplicitDijkstra")
+}
+
+// ImplicitAStar is ImplicitDijkstra guided by heuristic, which should never
+// overestimate the cost left to a goal state for the path to be the cheapest.
+func ImplicitAStar[S comparable, W Weight](g ImplicitGraph[S, W], source S, goal func(state S) bool, heuristic Heuristic[S, W], maxVisited int) (*StatePath[S, W], error) {
+	return implicitAStar(g, source, goal, heuristic, maxVisited, "ImplicitAStar")
+}
+
+type stateWrapper[S comparable, W Weight] struct {
+	state    S
+	priority W
+}
+
+type stateComparator[S comparable, W Weight] struct{}
+
+func (sc stateComparator[S, W]) Compare(one *stateWrapper[S, W], two *stateWrapper[S, W]) int {
+	if one.priority < two.priority {
+		return -1
+	}
+
+	if one.priority > two.priority {
+		return 1
+	}
+
+	return 0
+}
+
+func implicitAStar[S comparable, W Weight](g ImplicitGraph[S, W], source S, goal func(state S) bool, heuristic Heuristic[S, W], maxVisited int, operation erx.Operation) (*StatePath[S, W], error) {
+	ss, err := newStateSearch[S, W](source, maxVisited, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	q := queue.NewPriorityQueue[*stateWrapper[S, W]](false, stateComparator[S, W]{})
+	q.Add(&stateWrapper[S, W]{state: source, priority: heuristic(source)})
+
+	for !q.Empty() {
+		w, _ := q.Remove()
+
+		// skip entries queued before a cheaper route to the state was found.
+		if w.priority != ss.costs[w.state]+heuristic(w.state) {
+			continue
+		}
+
+		if goal(w.state) {
+			return ss.pathTo(w.state), nil
+		}
+
+		for _, n := range g.Neighbors(w.state) {
+			// a negative weight may close a cycle that keeps lowering the cost.
+			if n.Weight < 0 {
+				return nil, invalidParameterError("weight", n.Weight, operation)
+			}
+
+			if c, ok := ss.costs[n.State]; ok && c <= ss.costs[w.state]+n.Weight {
+				continue
+			}
+
+			if err := ss.reach(w.state, n); err != nil {
+				return nil, err
+			}
+
+			q.Add(&stateWrapper[S, W]{state: n.State, priority: ss.costs[n.State] + heuristic(n.State)})
+		}
+	}
+
+	return nil, goalNotFoundError(source, operation)
+}
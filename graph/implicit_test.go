@@ -0,0 +1,216 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func statesOf[S comparable](l list.List[S]) []S {
+	res := make([]S, 0)
+
+	it := l.Iterator()
+	for it.HasNext() {
+		s, _ := it.Next()
+		res = append(res, s)
+	}
+
+	return res
+}
+
+func is[S comparable](goal S) func(S) bool {
+	return func(s S) bool { return s == goal }
+}
+
+// a > c is a single step but costs more than going through b.
+var letters = NeighborFunc[string, int64](func(state string) []Neighbor[string, int64] {
+	return map[string][]Neighbor[string, int64]{
+		"a": {{State: "b", Weight: 1}, {State: "c", Weight: 5}},
+		"b": {{State: "c", Weight: 1}},
+		"c": {{State: "d", Weight: 1}},
+	}[state]
+})
+
+// a > b > a is a negative cycle and c is never reached.
+var loop = NeighborFunc[string, int64](func(state string) []Neighbor[string, int64] {
+	return map[string][]Neighbor[string, int64]{
+		"a": {{State: "b", Weight: 1}},
+		"b": {{State: "a", Weight: -2}},
+	}[state]
+})
+
+// line goes on forever, every number leads to the next one.
+var line = NeighborFunc[int, int64](func(state int) []Neighbor[int, int64] {
+	return []Neighbor[int, int64]{{State: state + 1, Weight: 1}}
+})
+
+func TestImplicitSearch(t *testing.T) {
+	testCases := map[string]struct {
+		search         func() (*StatePath[string, int64], error)
+		expectedStates []string
+		expectedWeight int64
+		expectedError  error
+	}{
+		"test bfs takes the fewest steps": {
+			search: func() (*StatePath[string, int64], error) {
+				return ImplicitBFS[string, int64](letters, "a", is("d"), 0)
+			},
+			expectedStates: []string{"a", "c", "d"},
+			expectedWeight: 6,
+		},
+		"test dijkstra takes the cheapest path": {
+			search: func() (*StatePath[string, int64], error) {
+				return ImplicitDijkstra[string, int64](letters, "a", is("d"), 0)
+			},
+			expectedStates: []string{"a", "b", "c", "d"},
+			expectedWeight: 3,
+		},
+		"test a star takes the cheapest path": {
+			search: func() (*StatePath[string, int64], error) {
+				h := func(s string) int64 { return int64('d' - s[0]) }
+				return ImplicitAStar[string, int64](letters, "a", is("d"), h, 0)
+			},
+			expectedStates: []string{"a", "b", "c", "d"},
+			expectedWeight: 3,
+		},
+		"test source is the goal": {
+			search: func() (*StatePath[string, int64], error) {
+				return ImplicitBFS[string, int64](letters, "c", is("c"), 0)
+			},
+			expectedStates: []string{"c"},
+			expectedWeight: 0,
+		},
+		"test bfs goal not reachable": {
+			search: func() (*StatePath[string, int64], error) {
+				return ImplicitBFS[string, int64](letters, "b", is("a"), 0)
+			},
+			expectedError: errors.New("no goal state reachable from b"),
+		},
+		"test dijkstra goal not reachable": {
+			search: func() (*StatePath[string, int64], error) {
+				return ImplicitDijkstra[string, int64](letters, "c", is("a"), 0)
+			},
+			expectedError: errors.New("no goal state reachable from c"),
+		},
+		"test visited bound is checked": {
+			search: func() (*StatePath[string, int64], error) {
+				return ImplicitDijkstra[string, int64](letters, "a", is("d"), 2)
+			},
+			expectedError: errors.New("search reached more than 2 states"),
+		},
+		"test dijkstra rejects negative weights": {
+			search: func() (*StatePath[string, int64], error) {
+				return ImplicitDijkstra[string, int64](loop, "a", is("c"), 0)
+			},
+			expectedError: errors.New("invalid weight -2"),
+		},
+		"test a star rejects negative weights": {
+			search: func() (*StatePath[string, int64], error) {
+				return ImplicitAStar[string, int64](loop, "a", is("c"), func(string) int64 { return 0 }, 0)
+			},
+			expectedError: errors.New("invalid weight -2"),
+		},
+		"test visited bound is not negative": {
+			search: func() (*StatePath[string, int64], error) {
+				return ImplicitBFS[string, int64](letters, "a", is("d"), -1)
+			},
+			expectedError: errors.New("invalid maxVisited -1"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res, err := testCase.search()
+
+			if testCase.expectedError != nil {
+				internal.AssertErrorEquals(t, testCase.expectedError, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStates, statesOf(res.States()))
+			assert.Equal(t, testCase.expectedWeight, res.Weight())
+		})
+	}
+}
+
+func TestImplicitSearchOnInfiniteGraph(t *testing.T) {
+	res, err := ImplicitBFS[int, int64](line, 0, is(50), 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), res.Weight())
+
+	_, err = ImplicitBFS[int, int64](line, 0, is(-1), 100)
+	internal.AssertErrorEquals(t, errors.New("search reached more than 100 states"), err)
+
+	_, err = ImplicitAStar[int, int64](line, 0, is(-1), func(int) int64 { return 0 }, 100)
+	internal.AssertErrorEquals(t, errors.New("search reached more than 100 states"), err)
+}
+
+type puzzle [9]int8
+
+// moves slides a tile into the blank, which is the zero.
+func (p puzzle) moves() []Neighbor[puzzle, int64] {
+	blank := 0
+	for p[blank] != 0 {
+		blank++
+	}
+
+	res := make([]Neighbor[puzzle, int64], 0, 4)
+
+	for _, d := range [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+		r, c := blank/3+d[0], blank%3+d[1]
+		if r < 0 || r > 2 || c < 0 || c > 2 {
+			continue
+		}
+
+		next := p
+		next[blank], next[r*3+c] = next[r*3+c], next[blank]
+		res = append(res, Neighbor[puzzle, int64]{State: next, Weight: 1})
+	}
+
+	return res
+}
+
+func (p puzzle) manhattan() int64 {
+	abs := func(x int) int64 {
+		if x < 0 {
+			return int64(-x)
+		}
+		return int64(x)
+	}
+
+	res := int64(0)
+	for i, v := range p {
+		if v != 0 {
+			res += abs(i/3-int(v-1)/3) + abs(i%3-int(v-1)%3)
+		}
+	}
+
+	return res
+}
+
+func TestImplicitSearchOnPuzzle(t *testing.T) {
+	solved := puzzle{1, 2, 3, 4, 5, 6, 7, 8, 0}
+	start := puzzle{4, 1, 3, 7, 2, 6, 0, 5, 8}
+
+	g := NeighborFunc[puzzle, int64](puzzle.moves)
+
+	bfs, err := ImplicitBFS[puzzle, int64](g, start, is(solved), 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), bfs.Weight())
+
+	astar, err := ImplicitAStar[puzzle, int64](g, start, is(solved), puzzle.manhattan, 0)
+	require.NoError(t, err)
+	assert.Equal(t, bfs.Weight(), astar.Weight())
+
+	states := statesOf(astar.States())
+	assert.Equal(t, start, states[0])
+	assert.Equal(t, solved, states[len(states)-1])
+
+	for i := 1; i < len(states); i++ {
+		assert.Contains(t, states[i-1].moves(), Neighbor[puzzle, int64]{State: states[i], Weight: 1})
+	}
+}
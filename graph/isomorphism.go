@@ -0,0 +1,396 @@
+package graph
+
+import (
+	"github.com/nsnikhil/go-datastructures/internal"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+)
+
+type edgeKey[T comparable, W Weight] struct {
+	from   T
+	to     T
+	label  string
+	weight W
+}
+
+func sameCounts[K comparable](a, b map[K]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, c := range a {
+		if b[k] != c {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal tells whether a and b have the same nodes and the same edges, nodes are
+// told apart by their data and edges by the data of their ends, their label and
+// their weight. The kind of the graphs and attributes are not compared.
+func Equal[T comparable, W Weight](a, b Graph[T, W]) bool {
+	a, b, unlock := readLockedPair(a, b)
+	defer unlock()
+
+	contents := func(g Graph[T, W]) (map[T]int, map[edgeKey[T, W]]int) {
+		nodes := make(map[T]int)
+		edges := make(map[edgeKey[T, W]]int)
+
+		it := g.Nodes()
+		for it.HasNext() {
+			n, _ := it.Next()
+			nodes[n.data]++
+
+			ei := n.edges.Iterator()
+			for ei.HasNext() {
+				e, _ := ei.Next()
+				edges[edgeKey[T, W]{from: n.data, to: e.next.data, label: e.label, weight: e.weight}]++
+			}
+		}
+
+		return nodes, edges
+	}
+
+	an, ae := contents(a)
+	bn, be := contents(b)
+
+	return sameCounts(an, bn) && sameCounts(ae, be)
+}
+
+// NodeMatcher tells whether node a of one graph may be mapped onto node b of
+// another one, a nil matcher lets any node be mapped onto any other.
+type NodeMatcher[T any, W Weight] func(a, b *Node[T, W]) bool
+
+// Isomorphism returns a mapping from the nodes of a onto the nodes of b that maps
+// every edge of a onto an edge of b and the other way around. Only the number of
+// edges between two nodes counts, their labels and weights do not.
+func Isomorphism[T any, W Weight](a, b Graph[T, W], match NodeMatcher[T, W]) (gmap.Map[*Node[T, W], *Node[T, W]], error) {
+	a, b, unlock := readLockedPair(a, b)
+	defer unlock()
+
+	s := newVF2State(a, b, match, false)
+
+	if len(s.nodes1) == len(s.nodes2) && s.edges1 == s.edges2 {
+		if res := s.search(1); len(res) > 0 {
+			return res[0], nil
+		}
+	}
+
+	return nil, notIsomorphicError("Isomorphism")
+}
+
+// IsIsomorphic tells whether Isomorphism finds a mapping from a onto b.
+func IsIsomorphic[T any, W Weight](a, b Graph[T, W], match NodeMatcher[T, W]) bool {
+	_, err := Isomorphism(a, b, match)
+	return err == nil
+}
+
+// SubgraphIsomorphisms returns up to limit mappings, all of them when limit is
+// zero, from the nodes of pattern onto nodes of g such that the subgraph of g
+// induced by those nodes is isomorphic to pattern.
+func SubgraphIsomorphisms[T any, W Weight](g, pattern Graph[T, W], match NodeMatcher[T, W], limit int) ([]gmap.Map[*Node[T, W], *Node[T, W]], error) {
+	g, pattern, unlock := readLockedPair(g, pattern)
+	defer unlock()
+
+	if limit < 0 {
+		return nil, invalidParameterError("limit", limit, "SubgraphIsomorphisms")
+	}
+
+	s := newVF2State(g, pattern, match, true)
+	if len(s.nodes2) > len(s.nodes1) {
+		return []gmap.Map[*Node[T, W], *Node[T, W]]{}, nil
+	}
+
+	// the mappings found go from g onto pattern.
+	found := s.search(limit)
+
+	res := make([]gmap.Map[*Node[T, W], *Node[T, W]], 0, len(found))
+	for _, m := range found {
+		inverse := gmap.NewHashMap[*Node[T, W], *Node[T, W]]()
+
+		it := m.Iterator()
+		for it.HasNext() {
+			p, _ := it.Next()
+			inverse.Put(p.Second(), p.First())
+		}
+
+		res = append(res, inverse)
+	}
+
+	return res, nil
+}
+
+// vf2State maps nodes of g1 onto nodes of g2 one pair at a time. Every node next
+// to a mapped one through an incoming or outgoing edge is in the in or out
+// terminal set of its graph, which records the depth at which it joined it.
+// Pairs are only tried if the edges to mapped nodes agree and the number of
+// neighbors in every terminal set, and out of all of them, can still agree.
+// When subgraph is set g2 has to be mapped onto an induced subgraph of g1, so
+// g1 may have more of those neighbors left.
+type vf2State[T any, W Weight] struct {
+	nodes1, nodes2 []*Node[T, W]
+	out1, out2     []map[int]int
+	in1, in2       []map[int]int
+	edges1, edges2 int
+
+	core1, core2 []int
+	inT1, inT2   []int
+	outT1, outT2 []int
+	depth        int
+	match        NodeMatcher[T, W]
+	subgraph     bool
+}
+
+func adjacencyCounts[T any, W Weight](g Graph[T, W]) ([]*Node[T, W], []map[int]int, []map[int]int, int) {
+	nodes, indexes := indexNodes(g)
+	out := make([]map[int]int, len(nodes))
+	in := make([]map[int]int, len(nodes))
+	edges := 0
+
+	for i := range nodes {
+		out[i] = make(map[int]int)
+		in[i] = make(map[int]int)
+	}
+
+	for i, n := range nodes {
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			j := indexes[e.next]
+
+			out[i][j]++
+			in[j][i]++
+			edges++
+		}
+	}
+
+	return nodes, out, in, edges
+}
+
+func unmapped(size int) []int {
+	res := make([]int, size)
+	for i := range res {
+		res[i] = internal.InvalidIndex
+	}
+
+	return res
+}
+
+func newVF2State[T any, W Weight](g1, g2 Graph[T, W], match NodeMatcher[T, W], subgraph bool) *vf2State[T, W] {
+	s := &vf2State[T, W]{match: match, subgraph: subgraph}
+
+	s.nodes1, s.out1, s.in1, s.edges1 = adjacencyCounts(g1)
+	s.nodes2, s.out2, s.in2, s.edges2 = adjacencyCounts(g2)
+
+	s.core1, s.core2 = unmapped(len(s.nodes1)), unmapped(len(s.nodes2))
+	s.inT1, s.inT2 = make([]int, len(s.nodes1)), make([]int, len(s.nodes2))
+	s.outT1, s.outT2 = make([]int, len(s.nodes1)), make([]int, len(s.nodes2))
+
+	return s
+}
+
+// search returns up to limit complete mappings from g1 onto g2, or all of them
+// when limit is zero.
+func (s *vf2State[T, W]) search(limit int) []gmap.Map[*Node[T, W], *Node[T, W]] {
+	res := make([]gmap.Map[*Node[T, W], *Node[T, W]], 0)
+
+	var match func() bool
+	match = func() bool {
+		if s.depth == len(s.nodes2) {
+			m := gmap.NewHashMap[*Node[T, W], *Node[T, W]]()
+			for i, j := range s.core1 {
+				if j != internal.InvalidIndex {
+					m.Put(s.nodes1[i], s.nodes2[j])
+				}
+			}
+
+			res = append(res, m)
+			return limit > 0 && len(res) == limit
+		}
+
+		candidates, n2 := s.candidates()
+		for _, n1 := range candidates {
+			if !s.feasible(n1, n2) {
+				continue
+			}
+
+			s.add(n1, n2)
+			done := match()
+			s.remove(n1, n2)
+
+			if done {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	match()
+	return res
+}
+
+func inTerminal(core, terminal []int, i int) bool {
+	return core[i] == internal.InvalidIndex && terminal[i] != 0
+}
+
+// candidates returns the nodes of g1 to try against the first node of g2 left in
+// the out terminal sets, or else in the in terminal sets, or else among all the
+// unmapped nodes. A node of g2 left in a terminal set can only be mapped onto a
+// node of g1 in the same set, so when there is none no candidate is returned.
+func (s *vf2State[T, W]) candidates() ([]int, int) {
+	pick := func(t1, t2 []int) ([]int, int) {
+		n2 := internal.InvalidIndex
+		for j := range s.nodes2 {
+			if inTerminal(s.core2, t2, j) {
+				n2 = j
+				break
+			}
+		}
+
+		res := make([]int, 0)
+		if n2 == internal.InvalidIndex {
+			return res, n2
+		}
+
+		for i := range s.nodes1 {
+			if inTerminal(s.core1, t1, i) {
+				res = append(res, i)
+			}
+		}
+
+		return res, n2
+	}
+
+	if res, n2 := pick(s.outT1, s.outT2); n2 != internal.InvalidIndex {
+		return res, n2
+	}
+
+	if res, n2 := pick(s.inT1, s.inT2); n2 != internal.InvalidIndex {
+		return res, n2
+	}
+
+	n2 := 0
+	for s.core2[n2] != internal.InvalidIndex {
+		n2++
+	}
+
+	res := make([]int, 0)
+	for i := range s.nodes1 {
+		if s.core1[i] == internal.InvalidIndex {
+			res = append(res, i)
+		}
+	}
+
+	return res, n2
+}
+
+func (s *vf2State[T, W]) feasible(n1, n2 int) bool {
+	if s.match != nil && !s.match(s.nodes1[n1], s.nodes2[n2]) {
+		return false
+	}
+
+	if s.out1[n1][n1] != s.out2[n2][n2] {
+		return false
+	}
+
+	return s.consistent(s.in1[n1], s.in2[n2]) && s.consistent(s.out1[n1], s.out2[n2]) &&
+		s.lookAhead(s.in1[n1], s.in2[n2]) && s.lookAhead(s.out1[n1], s.out2[n2])
+}
+
+// consistent tells whether the edges between the new pair and the mapped nodes
+// agree on both sides, for either incoming or outgoing edges.
+func (s *vf2State[T, W]) consistent(adj1, adj2 map[int]int) bool {
+	for m1, c := range adj1 {
+		if m2 := s.core1[m1]; m2 != internal.InvalidIndex && adj2[m2] != c {
+			return false
+		}
+	}
+
+	for m2, c := range adj2 {
+		if m1 := s.core2[m2]; m1 != internal.InvalidIndex && adj1[m1] != c {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookAhead compares the number of unmapped neighbors of the new pair in the in
+// terminal sets, in the out terminal sets and out of both.
+func (s *vf2State[T, W]) lookAhead(adj1, adj2 map[int]int) bool {
+	count := func(adj map[int]int, core, inT, outT []int) (int, int, int) {
+		in, out, rest := 0, 0, 0
+
+		for m := range adj {
+			if core[m] != internal.InvalidIndex {
+				continue
+			}
+
+			if inT[m] != 0 {
+				in++
+			}
+
+			if outT[m] != 0 {
+				out++
+			}
+
+			if inT[m] == 0 && outT[m] == 0 {
+				rest++
+			}
+		}
+
+		return in, out, rest
+	}
+
+	in1, out1, rest1 := count(adj1, s.core1, s.inT1, s.outT1)
+	in2, out2, rest2 := count(adj2, s.core2, s.inT2, s.outT2)
+
+	if s.subgraph {
+		return in1 >= in2 && out1 >= out2 && rest1 >= rest2
+	}
+
+	return in1 == in2 && out1 == out2 && rest1 == rest2
+}
+
+func (s *vf2State[T, W]) add(n1, n2 int) {
+	s.depth++
+	s.core1[n1], s.core2[n2] = n2, n1
+
+	join := func(n int, in, out []map[int]int, inT, outT []int) {
+		for _, t := range [][]int{inT, outT} {
+			if t[n] == 0 {
+				t[n] = s.depth
+			}
+		}
+
+		for m := range in[n] {
+			if inT[m] == 0 {
+				inT[m] = s.depth
+			}
+		}
+
+		for m := range out[n] {
+			if outT[m] == 0 {
+				outT[m] = s.depth
+			}
+		}
+	}
+
+	join(n1, s.in1, s.out1, s.inT1, s.outT1)
+	join(n2, s.in2, s.out2, s.inT2, s.outT2)
+}
+
+func (s *vf2State[T, W]) remove(n1, n2 int) {
+	for _, t := range [][]int{s.inT1, s.outT1, s.inT2, s.outT2} {
+		for i := range t {
+			if t[i] == s.depth {
+				t[i] = 0
+			}
+		}
+	}
+
+	s.core1[n1], s.core2[n2] = internal.InvalidIndex, internal.InvalidIndex
+	s.depth--
+}
@@ -0,0 +1,243 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"testing"
+)
+
+func sameData(a, b *Node[int, int64]) bool {
+	return a.data == b.data
+}
+
+// permuted copies g giving the node holding i the data perm[i].
+func permuted(g Graph[int, int64], perm []int) Graph[int, int64] {
+	res := newGraphLike(g)
+	copies := make(map[*Node[int, int64]]*Node[int, int64])
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		copies[n] = NewNode[int](perm[n.data])
+		res.AddNode(copies[n])
+	}
+
+	ei := g.Edges()
+	for ei.HasNext() {
+		e, _ := ei.Next()
+		_ = res.CreateLabeledDiEdge(copies[e.From()], copies[e.To()], e.Label(), e.Weight())
+	}
+
+	return res
+}
+
+// assertMapping checks that m is one to one and that there are as many edges
+// between two nodes of a as between the nodes of b they are mapped onto.
+func assertMapping(t *testing.T, a, b Graph[int, int64], m gmap.Map[*Node[int, int64], *Node[int, int64]]) {
+	images := make(map[*Node[int, int64]]bool)
+
+	it := m.Iterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		require.True(t, a.Contains(p.First()))
+		require.True(t, b.Contains(p.Second()))
+		images[p.Second()] = true
+	}
+
+	require.Len(t, images, int(m.Size()))
+
+	for x := m.Iterator(); x.HasNext(); {
+		p, _ := x.Next()
+
+		for y := m.Iterator(); y.HasNext(); {
+			q, _ := y.Next()
+
+			assert.Equal(t, first(a.EdgesBetween(p.First(), q.First())).Size(), first(b.EdgesBetween(p.Second(), q.Second())).Size())
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	for _, g := range getAllGraphs() {
+		c := g.Clone()
+		assert.True(t, Equal(g, c))
+
+		c.Reverse()
+		c.Reverse()
+		assert.True(t, Equal(g, c))
+
+		c.Reverse()
+		assert.True(t, Equal(g.Transpose(), c))
+
+		assert.True(t, Equal(g, toMatrixGraph(g)))
+	}
+
+	g, _ := graphOne()
+	r, _ := graphOneReverse()
+	assert.False(t, Equal(g, r))
+
+	heavier := g.Clone()
+	e, _ := heavier.Edges().Next()
+	require.NoError(t, heavier.CreateWeightedDiEdge(e.From(), e.To(), e.Weight()+1))
+	assert.False(t, Equal(g, heavier))
+
+	labeled := g.Clone()
+	e, _ = labeled.Edges().Next()
+	require.NoError(t, labeled.CreateLabeledDiEdge(e.From(), e.To(), "parallel", e.Weight()))
+	assert.False(t, Equal(g, labeled))
+
+	bigger := g.Clone()
+	bigger.AddNode(NewNode[int](7))
+	assert.False(t, Equal(g, bigger))
+}
+
+func TestIsomorphism(t *testing.T) {
+	for _, g := range getAllGraphs() {
+		mg := toMatrixGraph(g)
+
+		m, err := Isomorphism(g, mg, sameData)
+		require.NoError(t, err)
+		assertMapping(t, g, mg, m)
+
+		r := g.Clone()
+		r.Reverse()
+		assert.True(t, IsIsomorphic(g.Transpose(), r, sameData))
+	}
+
+	one, _ := graphOne()
+	oneReverse, _ := graphOneReverse()
+
+	m, err := Isomorphism(one, oneReverse, nil)
+	require.NoError(t, err)
+	assertMapping(t, one, oneReverse, m)
+
+	for kind, newGraph := range graphKinds {
+		t.Run(kind, func(t *testing.T) {
+			for seed := int64(0); seed < 10; seed++ {
+				gn := NewGenerator[int64](rand.NewSource(seed), newGraph, randomWeight)
+
+				g, _, err := gn.ErdosRenyi(12, 0.3, true)
+				require.NoError(t, err)
+
+				p := permuted(g, rand.New(rand.NewSource(seed)).Perm(12))
+
+				m, err := Isomorphism(g, p, nil)
+				require.NoError(t, err)
+				assertMapping(t, g, p, m)
+			}
+		})
+	}
+}
+
+func TestIsomorphismFailure(t *testing.T) {
+	path := func(reversed bool) Graph[int, int64] {
+		g := NewListGraph[int]()
+		a, b, c := NewNode[int](1), NewNode[int](2), NewNode[int](3)
+
+		createEdge(g, false, a, b)
+		if reversed {
+			createEdge(g, false, c, b)
+		} else {
+			createEdge(g, false, b, c)
+		}
+
+		return g
+	}
+
+	gn := NewGenerator[int64](rand.NewSource(1), NewListGraph[int], nil)
+	line, _, _ := gn.Grid(1, 4)
+
+	star := NewListGraph[int]()
+	createEdge(star, true, NewNode[int](0), NewNode[int](1), NewNode[int](2), NewNode[int](3))
+
+	testCases := map[string]struct {
+		a, b  Graph[int, int64]
+		match NodeMatcher[int, int64]
+	}{
+		"test edges in other directions":      {a: path(false), b: path(true)},
+		"test same counts of nodes and edges": {a: line, b: star},
+		"test different number of nodes":      {a: path(false), b: line},
+		"test nodes not matching": {
+			a:     path(false),
+			b:     permuted(path(false), []int{0, 3, 2, 1}),
+			match: sameData,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.False(t, IsIsomorphic(testCase.a, testCase.b, testCase.match))
+
+			_, err := Isomorphism(testCase.a, testCase.b, testCase.match)
+			internal.AssertErrorEquals(t, errors.New("graphs are not isomorphic"), err)
+		})
+	}
+}
+
+func TestSubgraphIsomorphisms(t *testing.T) {
+	gn := NewGenerator[int64](rand.NewSource(1), NewListGraph[int], nil)
+
+	k4, _, _ := gn.Complete(4, false)
+	triangle, _, _ := gn.Complete(3, false)
+	path, _, _ := gn.Grid(1, 3)
+	grid, _, _ := gn.Grid(3, 3)
+	square, _, _ := gn.Grid(2, 2)
+
+	testCases := map[string]struct {
+		g, pattern    Graph[int, int64]
+		limit         int
+		expectedCount int
+	}{
+		"test every triangle of a complete graph": {g: k4, pattern: triangle, expectedCount: 24},
+		"test limit is kept":                      {g: k4, pattern: triangle, limit: 5, expectedCount: 5},
+		"test subgraphs are induced":              {g: k4, pattern: path, expectedCount: 0},
+		"test squares of a grid":                  {g: grid, pattern: square, expectedCount: 4 * 8},
+		"test pattern larger than the graph":      {g: triangle, pattern: k4, expectedCount: 0},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res, err := SubgraphIsomorphisms(testCase.g, testCase.pattern, nil, testCase.limit)
+			require.NoError(t, err)
+			require.Len(t, res, testCase.expectedCount)
+
+			for _, m := range res {
+				assert.Equal(t, int(m.Size()), len(nodeData(testCase.pattern)))
+				assertMapping(t, testCase.pattern, testCase.g, m)
+			}
+		})
+	}
+
+	res, err := SubgraphIsomorphisms(grid, path, sameData, 0)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	_, err = SubgraphIsomorphisms(grid, path, nil, -1)
+	internal.AssertErrorEquals(t, errors.New("invalid limit -1"), err)
+}
+
+func TestVF2CandidatesCutBranchWithoutTerminalNodes(t *testing.T) {
+	g1 := NewListGraph[int]()
+	g1.AddNode(NewNode[int](1))
+	g1.AddNode(NewNode[int](2))
+
+	g2 := NewListGraph[int]()
+	createEdge(g2, false, NewNode[int](1), NewNode[int](2))
+
+	s := newVF2State[int, int64](g1, g2, nil, true)
+
+	n1, n2 := 0, 0
+	for s.out2[n2][1-n2] == 0 {
+		n2++
+	}
+
+	s.add(n1, n2)
+
+	res, next := s.candidates()
+	assert.Empty(t, res)
+	assert.Equal(t, 1-n2, next)
+}
@@ -1,7 +1,6 @@
 package graph
 
 import (
-	"errors"
 	"fmt"
 	"github.com/nsnikhil/erx"
 	"github.com/nsnikhil/go-datastructures/functions/iterator"
@@ -11,50 +10,68 @@ import (
 	"github.com/nsnikhil/go-datastructures/queue"
 	"github.com/nsnikhil/go-datastructures/set"
 	"github.com/nsnikhil/go-datastructures/stack"
-	"math"
 )
 
-type listGraph[T any] struct {
-	nodes set.Set[*Node[T]]
+type listGraph[T any, W Weight] struct {
+	nodes set.Set[*Node[T, W]]
 }
 
-func NewListGraph[T any]() Graph[T] {
-	return &listGraph[T]{
-		nodes: set.NewHashSet[*Node[T]](),
+func NewListGraph[T any]() Graph[T, int64] {
+	return NewWeightedListGraph[T, int64]()
+}
+
+func NewWeightedListGraph[T any, W Weight]() Graph[T, W] {
+	return &listGraph[T, W]{
+		nodes: set.NewHashSet[*Node[T, W]](),
 	}
 }
 
-func (lg *listGraph[T]) AddNode(n *Node[T]) {
+func (lg *listGraph[T, W]) AddNode(n *Node[T, W]) {
 	if !lg.Contains(n) {
 		lg.nodes.Add(n)
 	}
 }
 
-func (lg *listGraph[T]) CreateDiEdge(curr *Node[T], next *Node[T]) error {
-	return lg.createEdge(curr, next, 0)
+func (lg *listGraph[T, W]) CreateDiEdge(curr *Node[T, W], next *Node[T, W]) error {
+	return lg.createEdge(curr, next, "", 0)
 }
 
-func (lg *listGraph[T]) CreateWeightedDiEdge(curr, next *Node[T], weight int64) error {
-	return lg.createEdge(curr, next, weight)
+func (lg *listGraph[T, W]) CreateWeightedDiEdge(curr, next *Node[T, W], weight W) error {
+	return lg.createEdge(curr, next, "", weight)
 }
 
-func (lg *listGraph[T]) CreateBiEdge(curr *Node[T], next *Node[T]) error {
-	if err := lg.createEdge(curr, next, 0); err != nil {
+func (lg *listGraph[T, W]) CreateBiEdge(curr *Node[T, W], next *Node[T, W]) error {
+	if err := lg.createEdge(curr, next, "", 0); err != nil {
 		return err
 	}
 
-	return lg.createEdge(next, curr, 0)
+	return lg.createEdge(next, curr, "", 0)
+}
+
+func (lg *listGraph[T, W]) CreateWeightedBiEdge(curr, next *Node[T, W], weight W) error {
+	if err := lg.createEdge(curr, next, "", weight); err != nil {
+		return err
+	}
+
+	return lg.createEdge(next, curr, "", weight)
+}
+
+func (lg *listGraph[T, W]) CreateLabeledDiEdge(curr, next *Node[T, W], label string, weight W) error {
+	return lg.createEdge(curr, next, label, weight)
 }
 
-func (lg *listGraph[T]) CreateWeightedBiEdge(curr, next *Node[T], weight int64) error {
-	if err := lg.createEdge(curr, next, weight); err != nil {
+func (lg *listGraph[T, W]) CreateLabeledBiEdge(curr, next *Node[T, W], label string, weight W) error {
+	if err := lg.createEdge(curr, next, label, weight); err != nil {
 		return err
 	}
 
-	return lg.createEdge(next, curr, weight)
+	return lg.createEdge(next, curr, label, weight)
 }
 
-func (lg *listGraph[T]) createEdge(curr, next *Node[T], weight int64) error {
+// createEdge always adds a new edge, so edges created without a label between the
+// same nodes are kept side by side. A label names a single edge between two nodes
+// and cannot be used twice.
+func (lg *listGraph[T, W]) createEdge(curr, next *Node[T, W], label string, weight W) error {
 	if !lg.Contains(curr) {
 		return nodeNotFoundError(curr.data, "listGraph.createEdge")
 	}
@@ -63,12 +80,20 @@ func (lg *listGraph[T]) createEdge(curr, next *Node[T], weight int64) error {
 		return nodeNotFoundError(next.data, "listGraph.createEdge")
 	}
 
-	curr.addEdge(newWeightedDiEdge[T](next, weight))
+	if _, err := curr.findLabeledEdge(next, label); label != "" && err == nil {
+		return labeledEdgeExistsError(curr.data, next.data, label, "listGraph.createEdge")
+	}
+
+	curr.addEdge(newLabeledDiEdge[T, W](next, label, weight))
 	return nil
 }
 
+func (lg *listGraph[T, W]) attach(from, _ *Node[T, W], e *edge[T, W]) {
+	from.addEdge(e)
+}
+
 //TODO: EXPENSIVE IMPLEMENTATION
-func (lg *listGraph[T]) DeleteNode(n *Node[T]) error {
+func (lg *listGraph[T, W]) DeleteNode(n *Node[T, W]) error {
 	it := lg.nodes.Iterator()
 	for it.HasNext() {
 		v, _ := it.Next()
@@ -76,14 +101,10 @@ func (lg *listGraph[T]) DeleteNode(n *Node[T]) error {
 			continue
 		}
 
-		e, err := v.findEdge(n)
-		if err != nil {
-			continue
-		}
-
-		err = v.removeEdge(e)
-		if err != nil {
-			return erx.WithArgs(erx.Kind("listGraph.DeleteNode"), err)
+		for _, e := range v.findEdges(n) {
+			if err := v.removeEdge(e); err != nil {
+				return erx.WithArgs(erx.Kind("listGraph.DeleteNode"), err)
+			}
 		}
 	}
 
@@ -94,7 +115,7 @@ func (lg *listGraph[T]) DeleteNode(n *Node[T]) error {
 	return nil
 }
 
-func (lg *listGraph[T]) DeleteEdge(start, end *Node[T]) error {
+func (lg *listGraph[T, W]) DeleteEdge(start, end *Node[T, W]) error {
 	if !lg.Contains(start) {
 		return nodeNotFoundError(start.data, "listGraph.DeleteEdge")
 	}
@@ -103,82 +124,149 @@ func (lg *listGraph[T]) DeleteEdge(start, end *Node[T]) error {
 		return nodeNotFoundError(end.data, "listGraph.DeleteEdge")
 	}
 
-	e, err := start.findEdge(end)
+	edges := start.findEdges(end)
+	if len(edges) == 0 {
+		return edgeNotFoundError(start.data, end.data, "listGraph.DeleteEdge")
+	}
+
+	for _, e := range edges {
+		if err := start.removeEdge(e); err != nil {
+			return erx.WithArgs(erx.Kind("listGraph.DeleteEdge"), err)
+		}
+	}
+
+	return nil
+}
+
+func (lg *listGraph[T, W]) DeleteLabeledEdge(start, end *Node[T, W], label string) error {
+	if !lg.Contains(start) {
+		return nodeNotFoundError(start.data, "listGraph.DeleteLabeledEdge")
+	}
+
+	if !lg.Contains(end) {
+		return nodeNotFoundError(end.data, "listGraph.DeleteLabeledEdge")
+	}
+
+	e, err := start.findLabeledEdge(end, label)
 	if err != nil {
-		return erx.WithArgs(erx.Kind("listGraph.DeleteEdge"), err)
+		return erx.WithArgs(erx.Kind("listGraph.DeleteLabeledEdge"), err)
 	}
 
 	if err := start.removeEdge(e); err != nil {
-		return erx.WithArgs(erx.Kind("listGraph.DeleteEdge"), err)
+		return erx.WithArgs(erx.Kind("listGraph.DeleteLabeledEdge"), err)
 	}
 
 	return nil
 }
 
-func (lg *listGraph[T]) Contains(n *Node[T]) bool {
+func (lg *listGraph[T, W]) Contains(n *Node[T, W]) bool {
 	return lg.nodes.Contains(n)
 }
 
-func (lg *listGraph[T]) DFSIterator() iterator.Iterator[*Node[T]] {
-	return newListGraphIterator(false, lg)
+func (lg *listGraph[T, W]) Nodes() iterator.Iterator[*Node[T, W]] {
+	return lg.nodes.Iterator()
+}
+
+func (lg *listGraph[T, W]) Edges() iterator.Iterator[*Edge[T, W]] {
+	return &graphEdgeIterator[T, W]{nodesIterator: lg.nodes.Iterator()}
+}
+
+type graphEdgeIterator[T any, W Weight] struct {
+	curr          *Node[T, W]
+	nodesIterator iterator.Iterator[*Node[T, W]]
+	edgesIterator iterator.Iterator[*edge[T, W]]
+}
+
+func (gei *graphEdgeIterator[T, W]) HasNext() bool {
+	for gei.edgesIterator == nil || !gei.edgesIterator.HasNext() {
+		if !gei.nodesIterator.HasNext() {
+			return false
+		}
+
+		gei.curr, _ = gei.nodesIterator.Next()
+		gei.edgesIterator = gei.curr.edges.Iterator()
+	}
+
+	return true
+}
+
+func (gei *graphEdgeIterator[T, W]) Next() (*Edge[T, W], error) {
+	if !gei.HasNext() {
+		return nil, emptyIteratorError("graphEdgeIterator.Next")
+	}
+
+	e, err := gei.edgesIterator.Next()
+	if err != nil {
+		return nil, emptyIteratorError("graphEdgeIterator.Next")
+	}
+
+	return e.view(gei.curr), nil
+}
+
+func (lg *listGraph[T, W]) DFSIterator() iterator.Iterator[*Node[T, W]] {
+	return newGraphIterator[T, W](false, lg.nodes.Iterator())
 }
 
-func (lg *listGraph[T]) BFSIterator() iterator.Iterator[*Node[T]] {
-	return newListGraphIterator(true, lg)
+func (lg *listGraph[T, W]) BFSIterator() iterator.Iterator[*Node[T, W]] {
+	return newGraphIterator[T, W](true, lg.nodes.Iterator())
 }
 
-type listGraphIterator[T any] struct {
+type graphIterator[T any, W Weight] struct {
 	isBfs             bool
-	vs                set.Set[*Node[T]]
-	nodesIterator     iterator.Iterator[*Node[T]]
-	traversalIterator iterator.Iterator[*Node[T]]
+	vs                set.Set[*Node[T, W]]
+	nodesIterator     iterator.Iterator[*Node[T, W]]
+	traversalIterator iterator.Iterator[*Node[T, W]]
 }
 
-func (lgi *listGraphIterator[T]) HasNext() bool {
-	if lgi.traversalIterator == nil || !lgi.traversalIterator.HasNext() {
+func (gi *graphIterator[T, W]) HasNext() bool {
+	if gi.traversalIterator == nil || !gi.traversalIterator.HasNext() {
 
-		for lgi.nodesIterator.HasNext() {
-			n, _ := lgi.nodesIterator.Next()
-			if lgi.vs.Contains(n) {
+		for gi.nodesIterator.HasNext() {
+			n, _ := gi.nodesIterator.Next()
+			if gi.vs.Contains(n) {
 				continue
 			}
 
-			if lgi.isBfs {
-				lgi.traversalIterator = newNodeBfsIteratorWithVisited[T](n, lgi.vs)
+			if gi.isBfs {
+				gi.traversalIterator = newNodeBfsIteratorWithVisited[T, W](n, gi.vs)
 			} else {
-				lgi.traversalIterator = newNodeDfsIteratorWithVisited[T](n, lgi.vs)
+				gi.traversalIterator = newNodeDfsIteratorWithVisited[T, W](n, gi.vs)
 			}
 
 			break
 		}
 
-		return lgi.traversalIterator != nil && lgi.traversalIterator.HasNext()
+		return gi.traversalIterator != nil && gi.traversalIterator.HasNext()
 
 	}
 
 	return true
 }
 
-func (lgi *listGraphIterator[T]) Next() (*Node[T], error) {
-	v, err := lgi.traversalIterator.Next()
+func (gi *graphIterator[T, W]) Next() (*Node[T, W], error) {
+	v, err := gi.traversalIterator.Next()
 	if err != nil {
-		return nil, emptyIteratorError("listGraphBfsIterator.Next")
+		return nil, emptyIteratorError("graphIterator.Next")
 	}
 
 	return v, nil
 }
 
-func newListGraphIterator[T any](isBfs bool, graph *listGraph[T]) iterator.Iterator[*Node[T]] {
-	return &listGraphIterator[T]{
+func newGraphIterator[T any, W Weight](isBfs bool, nodesIterator iterator.Iterator[*Node[T, W]]) iterator.Iterator[*Node[T, W]] {
+	return &graphIterator[T, W]{
 		isBfs:         isBfs,
-		vs:            set.NewHashSet[*Node[T]](),
-		nodesIterator: graph.nodes.Iterator(),
+		vs:            set.NewHashSet[*Node[T, W]](),
+		nodesIterator: nodesIterator,
 	}
 }
 
-func (lg *listGraph[T]) Reverse() {
-	var reverseUtil func(curr *Node[T], vs set.Set[*Node[T]])
-	reverseUtil = func(curr *Node[T], vs set.Set[*Node[T]]) {
+func (lg *listGraph[T, W]) Reverse() {
+	reverse[T, W](lg)
+}
+
+func reverse[T any, W Weight](g Graph[T, W]) {
+	var reverseUtil func(curr *Node[T, W], vs set.Set[*Node[T, W]])
+	reverseUtil = func(curr *Node[T, W], vs set.Set[*Node[T, W]]) {
 		vs.Add(curr)
 
 		edges := curr.edges.Copy()
@@ -196,9 +284,9 @@ func (lg *listGraph[T]) Reverse() {
 		}
 	}
 
-	vs := set.NewHashSet[*Node[T]]()
+	vs := set.NewHashSet[*Node[T, W]]()
 
-	it := lg.nodes.Iterator()
+	it := g.Nodes()
 	for it.HasNext() {
 		v, _ := it.Next()
 		if !vs.Contains(v) {
@@ -207,11 +295,29 @@ func (lg *listGraph[T]) Reverse() {
 	}
 }
 
-func (lg *listGraph[T]) HasCycle() bool {
+// ParallelBFS returns the layers of a breadth first search from source, each one
+// expanded by up to workers goroutines.
+func (lg *listGraph[T, W]) ParallelBFS(source *Node[T, W], workers int) ([]list.List[*Node[T, W]], error) {
+	if !lg.Contains(source) {
+		return nil, nodeNotFoundError(source.data, "listGraph.ParallelBFS")
+	}
 
-	var check func(curr *Node[T], pd set.Set[*Node[T]], dn set.Set[*Node[T]]) bool
+	if workers < 1 {
+		return nil, invalidParameterError("workers", workers, "listGraph.ParallelBFS")
+	}
 
-	check = func(curr *Node[T], pd set.Set[*Node[T]], dn set.Set[*Node[T]]) bool {
+	return parallelBFS[T, W](lg, source, workers), nil
+}
+
+func (lg *listGraph[T, W]) HasCycle() bool {
+	return hasCycle[T, W](lg)
+}
+
+func hasCycle[T any, W Weight](g Graph[T, W]) bool {
+
+	var check func(curr *Node[T, W], pd set.Set[*Node[T, W]], dn set.Set[*Node[T, W]]) bool
+
+	check = func(curr *Node[T, W], pd set.Set[*Node[T, W]], dn set.Set[*Node[T, W]]) bool {
 		pd.Add(curr)
 
 		it := curr.edges.Iterator()
@@ -237,10 +343,10 @@ func (lg *listGraph[T]) HasCycle() bool {
 		return false
 	}
 
-	pd := set.NewHashSet[*Node[T]]()
-	dn := set.NewHashSet[*Node[T]]()
+	pd := set.NewHashSet[*Node[T, W]]()
+	dn := set.NewHashSet[*Node[T, W]]()
 
-	it := lg.nodes.Iterator()
+	it := g.Nodes()
 	for it.HasNext() {
 		v, _ := it.Next()
 		if dn.Contains(v) {
@@ -259,7 +365,7 @@ func (lg *listGraph[T]) HasCycle() bool {
 	return false
 }
 
-func (lg *listGraph[T]) HasLoop() bool {
+func (lg *listGraph[T, W]) HasLoop() bool {
 
 	ni := lg.nodes.Iterator()
 	for ni.HasNext() {
@@ -278,7 +384,7 @@ func (lg *listGraph[T]) HasLoop() bool {
 }
 
 //TODO: WHAT IF THEIR IS A EDGE FROM B TO A?
-func (lg *listGraph[T]) AreAdjacent(a, b *Node[T]) (bool, error) {
+func (lg *listGraph[T, W]) AreAdjacent(a, b *Node[T, W]) (bool, error) {
 	if !lg.Contains(a) {
 		return false, nodeNotFoundError(a.data, "listGraph.AreAdjacent")
 	}
@@ -294,7 +400,53 @@ func (lg *listGraph[T]) AreAdjacent(a, b *Node[T]) (bool, error) {
 	return false, edgeNotFoundError(a.data, b.data, "listGraph.AreAdjacent")
 }
 
-func (lg *listGraph[T]) InDegreeOfNode(a *Node[T]) (int64, error) {
+func (lg *listGraph[T, W]) EdgeWeight(a, b *Node[T, W]) (W, error) {
+	if !lg.Contains(a) {
+		return internal.Zero, nodeNotFoundError(a.data, "listGraph.EdgeWeight")
+	}
+
+	if !lg.Contains(b) {
+		return internal.Zero, nodeNotFoundError(b.data, "listGraph.EdgeWeight")
+	}
+
+	e, err := a.findEdge(b)
+	if err != nil {
+		return internal.Zero, edgeNotFoundError(a.data, b.data, "listGraph.EdgeWeight")
+	}
+
+	return e.weight, nil
+}
+
+func (lg *listGraph[T, W]) FindEdge(a, b *Node[T, W], label string) (*Edge[T, W], error) {
+	if !lg.Contains(a) {
+		return nil, nodeNotFoundError(a.data, "listGraph.FindEdge")
+	}
+
+	if !lg.Contains(b) {
+		return nil, nodeNotFoundError(b.data, "listGraph.FindEdge")
+	}
+
+	e, err := a.findLabeledEdge(b, label)
+	if err != nil {
+		return nil, erx.WithArgs(erx.Kind("listGraph.FindEdge"), err)
+	}
+
+	return e.view(a), nil
+}
+
+func (lg *listGraph[T, W]) EdgesBetween(a, b *Node[T, W]) (list.List[*Edge[T, W]], error) {
+	if !lg.Contains(a) {
+		return nil, nodeNotFoundError(a.data, "listGraph.EdgesBetween")
+	}
+
+	if !lg.Contains(b) {
+		return nil, nodeNotFoundError(b.data, "listGraph.EdgesBetween")
+	}
+
+	return edgesBetween(a, b), nil
+}
+
+func (lg *listGraph[T, W]) InDegreeOfNode(a *Node[T, W]) (int64, error) {
 	if !lg.Contains(a) {
 		return internal.Zero, nodeNotFoundError(a.data, "listGraph.InDegreeOfNode")
 	}
@@ -317,7 +469,7 @@ func (lg *listGraph[T]) InDegreeOfNode(a *Node[T]) (int64, error) {
 	return res, nil
 }
 
-func (lg *listGraph[T]) OutDegreeOfNode(a *Node[T]) (int64, error) {
+func (lg *listGraph[T, W]) OutDegreeOfNode(a *Node[T, W]) (int64, error) {
 	if !lg.Contains(a) {
 		return internal.Zero, nodeNotFoundError(a.data, "listGraph.OutDegreeOfNode")
 	}
@@ -325,19 +477,20 @@ func (lg *listGraph[T]) OutDegreeOfNode(a *Node[T]) (int64, error) {
 	return a.edges.Size(), nil
 }
 
-//func (lg *listGraph[T]) HasBridge() bool {
+//func (lg *listGraph[T, W]) HasBridge() bool {
 //	return false
 //}
 
-func (lg *listGraph[T]) Clone() Graph[T] {
+func (lg *listGraph[T, W]) Clone() Graph[T, W] {
 
-	var cl func(curr *Node[T], cache gmap.Map[*Node[T], *Node[T]]) *Node[T]
-	cl = func(curr *Node[T], cache gmap.Map[*Node[T], *Node[T]]) *Node[T] {
+	var cl func(curr *Node[T, W], cache gmap.Map[*Node[T, W], *Node[T, W]]) *Node[T, W]
+	cl = func(curr *Node[T, W], cache gmap.Map[*Node[T, W], *Node[T, W]]) *Node[T, W] {
 		if v, err := cache.Get(curr); v != nil && err == nil {
 			return v
 		}
 
-		n := NewNode(curr.data)
+		n := NewWeightedNode[T, W](curr.data)
+		n.attributes = curr.attributes.copy()
 		cache.Put(curr, n)
 
 		it := curr.edges.Iterator()
@@ -345,23 +498,22 @@ func (lg *listGraph[T]) Clone() Graph[T] {
 			e, _ := it.Next()
 
 			nx := e.next
-			var ne *edge[T]
+			var ne *edge[T, W]
 
 			if v, err := cache.Get(nx); v != nil && err == nil {
-				ne = newDiEdge[T](v)
+				ne = e.copyTo(v)
 			} else {
-				ne = newDiEdge[T](cl(nx, cache))
+				ne = e.copyTo(cl(nx, cache))
 			}
 
-			ne.weight = e.weight
 			n.addEdge(ne)
 		}
 
 		return n
 	}
 
-	cache := gmap.NewHashMap[*Node[T], *Node[T]]()
-	nodes := set.NewHashSet[*Node[T]]()
+	cache := gmap.NewHashMap[*Node[T, W], *Node[T, W]]()
+	nodes := set.NewHashSet[*Node[T, W]]()
 
 	it := lg.nodes.Iterator()
 	for it.HasNext() {
@@ -375,12 +527,12 @@ func (lg *listGraph[T]) Clone() Graph[T] {
 		}
 	}
 
-	return &listGraph[T]{
+	return &listGraph[T, W]{
 		nodes: nodes,
 	}
 }
 
-func (lg *listGraph[T]) HasRoute(source, target *Node[T]) (bool, error) {
+func (lg *listGraph[T, W]) HasRoute(source, target *Node[T, W]) (bool, error) {
 	if !lg.Contains(source) {
 		return false, nodeNotFoundError(source.data, "listGraph.HasRoute")
 	}
@@ -389,9 +541,13 @@ func (lg *listGraph[T]) HasRoute(source, target *Node[T]) (bool, error) {
 		return false, nodeNotFoundError(target.data, "listGraph.HasRoute")
 	}
 
-	var visit func(curr, target *Node[T], visited set.Set[*Node[T]]) bool
+	return hasRoute(source, target), nil
+}
+
+func hasRoute[T any, W Weight](source, target *Node[T, W]) bool {
+	var visit func(curr, target *Node[T, W], visited set.Set[*Node[T, W]]) bool
 
-	visit = func(curr, target *Node[T], visited set.Set[*Node[T]]) bool {
+	visit = func(curr, target *Node[T, W], visited set.Set[*Node[T, W]]) bool {
 		visited.Add(curr)
 
 		if curr == target {
@@ -419,28 +575,71 @@ func (lg *listGraph[T]) HasRoute(source, target *Node[T]) (bool, error) {
 		return found
 	}
 
-	visited := set.NewHashSet[*Node[T]]()
+	visited := set.NewHashSet[*Node[T, W]]()
 
-	return visit(source, target, visited), nil
+	return visit(source, target, visited)
 }
 
-//func (lg *listGraph[T]) IsDirected() bool {
+//func (lg *listGraph[T, W]) IsDirected() bool {
 //	return false
 //}
 //
-//func (lg *listGraph[T]) IsConnected() bool {
+//func (lg *listGraph[T, W]) IsConnected() bool {
 //	return false
 //}
 
-func (lg *listGraph[T]) GetConnectedComponents() []list.List[*Node[T]] {
-	return koasraju(lg)
+func (lg *listGraph[T, W]) TopologicalSort() (list.List[*Node[T, W]], error) {
+	return topologicalOrder[T, W](lg, "listGraph.TopologicalSort")
+}
+
+func (lg *listGraph[T, W]) TopologicalLayers() ([]list.List[*Node[T, W]], error) {
+	return topologicalLayers[T, W](lg, "listGraph.TopologicalLayers")
+}
+
+func (lg *listGraph[T, W]) GetConnectedComponents() []list.List[*Node[T, W]] {
+	return koasraju[T, W](lg)
 }
 
-func koasraju[T any](lg *listGraph[T]) []list.List[*Node[T]] {
+func (lg *listGraph[T, W]) StronglyConnectedComponents() []list.List[*Node[T, W]] {
+	return tarjan[T, W](lg)
+}
 
-	var pushToStack func(node *Node[T], visited set.Set[*Node[T]], st *stack.Stack[*Node[T]])
+func (lg *listGraph[T, W]) WeaklyConnectedComponents() []list.List[*Node[T, W]] {
+	return weaklyConnectedComponents[T, W](lg)
+}
 
-	pushToStack = func(node *Node[T], visited set.Set[*Node[T]], st *stack.Stack[*Node[T]]) {
+func (lg *listGraph[T, W]) Bridges() (list.List[*Edge[T, W]], error) {
+	if !isUndirected[T, W](lg) {
+		return nil, undirectedGraphRequiredError("listGraph.Bridges")
+	}
+
+	return newBiconnectivity[T, W](lg).bridges, nil
+}
+
+func (lg *listGraph[T, W]) ArticulationPoints() (list.List[*Node[T, W]], error) {
+	if !isUndirected[T, W](lg) {
+		return nil, undirectedGraphRequiredError("listGraph.ArticulationPoints")
+	}
+
+	return newBiconnectivity[T, W](lg).articulationPoints, nil
+}
+
+func (lg *listGraph[T, W]) BiconnectedComponents() ([]list.List[*Node[T, W]], error) {
+	if !isUndirected[T, W](lg) {
+		return nil, undirectedGraphRequiredError("listGraph.BiconnectedComponents")
+	}
+
+	return newBiconnectivity[T, W](lg).components, nil
+}
+
+// koasraju walks the graph a second time along incoming edges instead of reversing
+// it, so that the graph is never changed while its components are read.
+func koasraju[T any, W Weight](g Graph[T, W]) []list.List[*Node[T, W]] {
+	incoming := incomingSteps(g)
+
+	var pushToStack func(node *Node[T, W], visited set.Set[*Node[T, W]], st *stack.Stack[*Node[T, W]])
+
+	pushToStack = func(node *Node[T, W], visited set.Set[*Node[T, W]], st *stack.Stack[*Node[T, W]]) {
 		visited.Add(node)
 
 		it := node.edges.Iterator()
@@ -455,27 +654,24 @@ func koasraju[T any](lg *listGraph[T]) []list.List[*Node[T]] {
 		st.Push(node)
 	}
 
-	var printComponent func(node *Node[T], visited set.Set[*Node[T]], temp list.List[*Node[T]])
+	var printComponent func(node *Node[T, W], visited set.Set[*Node[T, W]], temp list.List[*Node[T, W]])
 
-	printComponent = func(node *Node[T], visited set.Set[*Node[T]], temp list.List[*Node[T]]) {
+	printComponent = func(node *Node[T, W], visited set.Set[*Node[T, W]], temp list.List[*Node[T, W]]) {
 		visited.Add(node)
 
-		it := node.edges.Iterator()
-		for it.HasNext() {
-			e, _ := it.Next()
-			n := e.next
-			if !visited.Contains(n) {
-				printComponent(n, visited, temp)
+		for _, s := range incoming(node) {
+			if !visited.Contains(s.next) {
+				printComponent(s.next, visited, temp)
 			}
 		}
 
 		temp.Add(node)
 	}
 
-	st := stack.NewStack[*Node[T]]()
-	visited := set.NewHashSet[*Node[T]]()
+	st := stack.NewStack[*Node[T, W]]()
+	visited := set.NewHashSet[*Node[T, W]]()
 
-	it := lg.nodes.Iterator()
+	it := g.Nodes()
 	for it.HasNext() {
 		v, _ := it.Next()
 		node := v
@@ -484,18 +680,15 @@ func koasraju[T any](lg *listGraph[T]) []list.List[*Node[T]] {
 		}
 	}
 
-	//TODO: MUTATING THE GRAPH
-	lg.Reverse()
-
 	visited.Clear()
 
-	res := make([]list.List[*Node[T]], 0)
+	res := make([]list.List[*Node[T, W]], 0)
 
 	for !st.Empty() {
 		n, _ := st.Pop()
 
 		if !visited.Contains(n) {
-			temp := list.NewArrayList[*Node[T]]()
+			temp := list.NewArrayList[*Node[T, W]]()
 			printComponent(n, visited, temp)
 			res = append(res, temp)
 		}
@@ -504,7 +697,7 @@ func koasraju[T any](lg *listGraph[T]) []list.List[*Node[T]] {
 	return res
 }
 
-func (lg *listGraph[T]) ShortestPath(source, target *Node[T], properties ...Property) (list.List[*Node[T]], error) {
+func (lg *listGraph[T, W]) ShortestPath(source, target *Node[T, W], properties ...Property) (list.List[*Node[T, W]], error) {
 	if !lg.Contains(source) {
 		return nil, nodeNotFoundError(source.data, "listGraph.ShortestPath")
 	}
@@ -513,6 +706,104 @@ func (lg *listGraph[T]) ShortestPath(source, target *Node[T], properties ...Prop
 		return nil, nodeNotFoundError(target.data, "listGraph.ShortestPath")
 	}
 
+	return shortestPath[T, W](lg, source, target, properties...)
+}
+
+func (lg *listGraph[T, W]) ShortestPathTree(source *Node[T, W], properties ...Property) (*ShortestPathTree[T, W], error) {
+	if !lg.Contains(source) {
+		return nil, nodeNotFoundError(source.data, "listGraph.ShortestPathTree")
+	}
+
+	return shortestPathTree[T, W](lg, source, properties...)
+}
+
+func (lg *listGraph[T, W]) FloydWarshall() (*AllPairsShortestPaths[T, W], error) {
+	return floydWarshall[T, W](lg)
+}
+
+func (lg *listGraph[T, W]) Johnson() (*AllPairsShortestPaths[T, W], error) {
+	return johnson[T, W](lg)
+}
+
+func (lg *listGraph[T, W]) AStar(source, target *Node[T, W], heuristic Heuristic[T, W]) (*PathResult[T, W], error) {
+	if err := checkEndpoints[T, W](lg, source, target, "listGraph.AStar"); err != nil {
+		return nil, err
+	}
+
+	return aStar[T, W](lg, source, target, heuristic)
+}
+
+func (lg *listGraph[T, W]) BidirectionalDijkstra(source, target *Node[T, W]) (*PathResult[T, W], error) {
+	if err := checkEndpoints[T, W](lg, source, target, "listGraph.BidirectionalDijkstra"); err != nil {
+		return nil, err
+	}
+
+	return bidirectionalDijkstra[T, W](lg, source, target)
+}
+
+func (lg *listGraph[T, W]) BidirectionalBFS(source, target *Node[T, W]) (*PathResult[T, W], error) {
+	if err := checkEndpoints[T, W](lg, source, target, "listGraph.BidirectionalBFS"); err != nil {
+		return nil, err
+	}
+
+	return bidirectionalBFS[T, W](lg, source, target)
+}
+
+func (lg *listGraph[T, W]) Subgraph(nodes list.List[*Node[T, W]]) (Graph[T, W], error) {
+	return subgraph[T, W](lg, nodes, "listGraph.Subgraph")
+}
+
+func (lg *listGraph[T, W]) EdgeSubgraph(edges list.List[*Edge[T, W]]) (Graph[T, W], error) {
+	return edgeSubgraph[T, W](lg, edges, "listGraph.EdgeSubgraph")
+}
+
+func (lg *listGraph[T, W]) Neighborhood(n *Node[T, W], k int) (Graph[T, W], error) {
+	if !lg.Contains(n) {
+		return nil, nodeNotFoundError(n.data, "listGraph.Neighborhood")
+	}
+
+	if k < 0 {
+		return nil, negativeHopCountError(k, "listGraph.Neighborhood")
+	}
+
+	return neighborhood[T, W](lg, n, k), nil
+}
+
+func (lg *listGraph[T, W]) Contract(nodes list.List[*Node[T, W]], data T) (Graph[T, W], error) {
+	return contraction[T, W](lg, nodes, data, "listGraph.Contract")
+}
+
+func (lg *listGraph[T, W]) Complement() Graph[T, W] {
+	return complement[T, W](lg)
+}
+
+func (lg *listGraph[T, W]) Transpose() Graph[T, W] {
+	return transpose[T, W](lg)
+}
+
+func (lg *listGraph[T, W]) KruskalMST() (Graph[T, W], W, error) {
+	return kruskal[T, W](lg, NewWeightedListGraph[T, W])
+}
+
+func (lg *listGraph[T, W]) PrimMST() (Graph[T, W], W, error) {
+	return prim[T, W](lg, NewWeightedListGraph[T, W])
+}
+
+func shortestPath[T any, W Weight](g Graph[T, W], source, target *Node[T, W], properties ...Property) (list.List[*Node[T, W]], error) {
+	spt, err := shortestPathTree(g, source, properties...)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := spt.PathTo(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return pr.Nodes(), nil
+}
+
+func shortestPathTree[T any, W Weight](g Graph[T, W], source *Node[T, W], properties ...Property) (*ShortestPathTree[T, W], error) {
 	toPropertySet := func(properties ...Property) set.Set[Property] {
 		res := set.NewHashSet[Property]()
 		for _, property := range properties {
@@ -523,125 +814,81 @@ func (lg *listGraph[T]) ShortestPath(source, target *Node[T], properties ...Prop
 
 	ps := toPropertySet(properties...)
 
-	//TODO: REFACTOR ALL THE ALGORITHM CONTAINS DUPLICATE CODE TO BACKTRACK NODES
-
 	//UnWeighted Graph -> BFS
 	if ps.Contains(UnWeighted) {
-		return nonWeightedShortestPath(source, target)
+		return nonWeightedShortestPath(source), nil
 	}
 
 	//DAG -> TOPOLOGICAL SORT
 	if ps.Contains(Directed) && ps.Contains(ACyclic) {
-		return dagShortestPath(source, target, lg)
+		return dagShortestPath(source, g), nil
 	}
 
 	//NON NEGATIVE WEIGHTS -> DIJKSTRA
 	if ps.Contains(NonNegativeWeights) {
-		return dijkstra(source, target, lg)
+		return dijkstra(source, g, weightOfEdge[T, W]), nil
 	}
 
 	//GENERAL CASE -> BELLMEN FORD
-	return bellmenFord(source, target, lg)
+	return bellmenFord(source, g)
 }
 
-func nonWeightedShortestPath[T any](source, target *Node[T]) (list.List[*Node[T]], error) {
-	vs := set.NewHashSet[*Node[T]]()
-	q := queue.NewLinkedQueue[*Node[T]]()
+// nonWeightedShortestPath measures the distance in number of edges.
+func nonWeightedShortestPath[T any, W Weight](source *Node[T, W]) *ShortestPathTree[T, W] {
+	q := queue.NewLinkedQueue[*Node[T, W]]()
 
-	pm := gmap.NewHashMap[*Node[T], *Node[T]]()
+	cm := gmap.NewHashMap[*Node[T, W], W]()
+	pm := gmap.NewHashMap[*Node[T, W], *Node[T, W]]()
 
 	q.Add(source)
-	vs.Add(source)
+	cm.Put(source, internal.Zero)
 	pm.Put(source, nil)
 
 	for !q.Empty() {
-		sz := q.Size()
+		n, _ := q.Remove()
 
-		found := false
-		for i := int64(0); i < sz; i++ {
-			n, _ := q.Remove()
-
-			it := n.edges.Iterator()
-			for it.HasNext() {
-				e, _ := it.Next()
-				nx := e.next
-
-				if !vs.Contains(nx) {
-					if !pm.ContainsKey(nx) {
-						pm.Put(nx, n)
-					}
-
-					if nx == target {
-						found = true
-						break
-					}
-
-					vs.Add(nx)
-					q.Add(nx)
-				}
-			}
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			nx := e.next
 
-			if found {
-				break
+			if !cm.ContainsKey(nx) {
+				cm.Put(nx, first(cm.Get(n))+1)
+				pm.Put(nx, n)
+				q.Add(nx)
 			}
-
-		}
-
-		if found {
-			break
 		}
 	}
 
-	curr := target
-	if !pm.ContainsKey(target) {
-		//TODO: REFACTOR OPERATION
-		return nil, pathNotFoundError(source.data, target.data, "nonWeightedShortestPath")
-	}
-
-	res := list.NewLinkedList[*Node[T]]()
-
-	for pm.ContainsKey(curr) {
-		res.AddFirst(curr)
-
-		if curr == source {
-			break
-		}
-
-		par, err := pm.Get(curr)
-		if err != nil {
-			//TODO: REFACTOR OPERATION
-			return nil, pathNotFoundError(source.data, target.data, "nonWeightedShortestPath")
-		}
-
-		curr = par
-	}
+	return newShortestPathTree(source, cm, pm)
+}
 
-	return res, nil
+// a node missing from the cost map has not been reached yet, which stands for an
+// infinite cost without needing the largest value of every weight type.
+func isCheaper[T any, W Weight](cm gmap.Map[*Node[T, W], W], n *Node[T, W], cost W) bool {
+	c, err := cm.Get(n)
+	return err != nil || cost < c
 }
 
-func dagShortestPath[T any](source, target *Node[T], lg *listGraph[T]) (list.List[*Node[T]], error) {
-	cm := gmap.NewHashMap[*Node[T], int64]()
-	pm := gmap.NewHashMap[*Node[T], *Node[T]]()
+func dagShortestPath[T any, W Weight](source *Node[T, W], g Graph[T, W]) *ShortestPathTree[T, W] {
+	cm := gmap.NewHashMap[*Node[T, W], W]()
+	pm := gmap.NewHashMap[*Node[T, W], *Node[T, W]]()
 
-	sortedNodes := lg.topologicalSort()
+	sortedNodes := topologicalSort(g)
 
-	it := lg.nodes.Iterator()
+	it := g.Nodes()
 	for it.HasNext() {
 		n, _ := it.Next()
 		pm.Put(n, nil)
-
-		if n == source {
-			cm.Put(n, internal.Zero)
-		} else {
-			cm.Put(n, math.MaxInt64)
-		}
 	}
 
+	cm.Put(source, internal.Zero)
+
 	for !sortedNodes.Empty() {
 		n, _ := sortedNodes.Pop()
 
-		currCost, _ := cm.Get(n)
-		if currCost == math.MaxInt64 {
+		currCost, err := cm.Get(n)
+		if err != nil {
 			continue
 		}
 
@@ -651,46 +898,20 @@ func dagShortestPath[T any](source, target *Node[T], lg *listGraph[T]) (list.Lis
 			e, _ := it.Next()
 			nx := e.next
 
-			costToReachNext, _ := cm.Get(nx)
-
-			if costToReachNext > currCost+e.weight {
+			if isCheaper(cm, nx, currCost+e.weight) {
 				cm.Put(nx, currCost+e.weight)
 				pm.Put(nx, n)
 			}
 		}
 	}
 
-	curr := target
-	if !pm.ContainsKey(target) || first(cm.Get(target)) == math.MaxInt64 {
-		//TODO: REFACTOR OPERATION
-		return nil, pathNotFoundError(source.data, target.data, "dagShortestPath")
-	}
-
-	res := list.NewLinkedList[*Node[T]]()
-
-	for pm.ContainsKey(curr) {
-		res.AddFirst(curr)
-
-		if curr == source {
-			break
-		}
-
-		par, err := pm.Get(curr)
-		if err != nil {
-			//TODO: REFACTOR OPERATION
-			return nil, pathNotFoundError(source.data, target.data, "dagShortestPath")
-		}
-
-		curr = par
-	}
-
-	return res, nil
+	return newShortestPathTree(source, cm, pm)
 }
 
-func (lg *listGraph[T]) topologicalSort() *stack.Stack[*Node[T]] {
-	var topologicalSortUtil func(n *Node[T], vs set.Set[*Node[T]], st *stack.Stack[*Node[T]])
+func topologicalSort[T any, W Weight](g Graph[T, W]) *stack.Stack[*Node[T, W]] {
+	var topologicalSortUtil func(n *Node[T, W], vs set.Set[*Node[T, W]], st *stack.Stack[*Node[T, W]])
 
-	topologicalSortUtil = func(n *Node[T], vs set.Set[*Node[T]], st *stack.Stack[*Node[T]]) {
+	topologicalSortUtil = func(n *Node[T, W], vs set.Set[*Node[T, W]], st *stack.Stack[*Node[T, W]]) {
 		if n == nil || vs.Contains(n) {
 			return
 		}
@@ -709,10 +930,10 @@ func (lg *listGraph[T]) topologicalSort() *stack.Stack[*Node[T]] {
 		vs.Add(n)
 	}
 
-	st := stack.NewStack[*Node[T]]()
-	vs := set.NewHashSet[*Node[T]]()
+	st := stack.NewStack[*Node[T, W]]()
+	vs := set.NewHashSet[*Node[T, W]]()
 
-	it := lg.nodes.Iterator()
+	it := g.Nodes()
 	for it.HasNext() {
 		n, _ := it.Next()
 		if !vs.Contains(n) {
@@ -723,38 +944,52 @@ func (lg *listGraph[T]) topologicalSort() *stack.Stack[*Node[T]] {
 	return st
 }
 
-type nodeWrapper[T any] struct {
-	curr        *Node[T]
-	predecessor *Node[T]
-	costToReach int64
+type nodeWrapper[T any, W Weight] struct {
+	curr        *Node[T, W]
+	predecessor *Node[T, W]
+	costToReach W
 }
 
-type nodeComparator[T any] struct{}
+type nodeComparator[T any, W Weight] struct{}
 
-func (nc *nodeComparator[T]) Compare(one *nodeWrapper[T], two *nodeWrapper[T]) int {
-	return int(one.costToReach - two.costToReach)
+func (nc *nodeComparator[T, W]) Compare(one *nodeWrapper[T, W], two *nodeWrapper[T, W]) int {
+	if one.costToReach < two.costToReach {
+		return -1
+	}
+
+	if one.costToReach > two.costToReach {
+		return 1
+	}
+
+	return 0
 }
 
-func dijkstra[T any](source, target *Node[T], lg *listGraph[T]) (list.List[*Node[T]], error) {
+type weightFunc[T any, W Weight] func(from *Node[T, W], e *edge[T, W]) W
+
+func weightOfEdge[T any, W Weight](_ *Node[T, W], e *edge[T, W]) W {
+	return e.weight
+}
+
+func dijkstra[T any, W Weight](source *Node[T, W], g Graph[T, W], weightOf weightFunc[T, W]) *ShortestPathTree[T, W] {
 
 	var relaxCost func(
-		*nodeWrapper[T],
-		queue.Queue[*nodeWrapper[T]],
-		gmap.Map[*Node[T], int64],
-		gmap.Map[*Node[T], *Node[T]],
-		set.Set[*Node[T]],
+		*nodeWrapper[T, W],
+		queue.Queue[*nodeWrapper[T, W]],
+		gmap.Map[*Node[T, W], W],
+		gmap.Map[*Node[T, W], *Node[T, W]],
+		set.Set[*Node[T, W]],
 	)
 
 	relaxCost = func(
-		currWrapper *nodeWrapper[T],
-		q queue.Queue[*nodeWrapper[T]],
-		cm gmap.Map[*Node[T], int64],
-		pm gmap.Map[*Node[T], *Node[T]],
-		relaxedNodes set.Set[*Node[T]],
+		currWrapper *nodeWrapper[T, W],
+		q queue.Queue[*nodeWrapper[T, W]],
+		cm gmap.Map[*Node[T, W], W],
+		pm gmap.Map[*Node[T, W], *Node[T, W]],
+		relaxedNodes set.Set[*Node[T, W]],
 	) {
 
-		currCost, _ := cm.Get(currWrapper.curr)
-		if currCost == math.MaxInt64 {
+		currCost, err := cm.Get(currWrapper.curr)
+		if err != nil {
 			return
 		}
 
@@ -767,37 +1002,31 @@ func dijkstra[T any](source, target *Node[T], lg *listGraph[T]) (list.List[*Node
 				continue
 			}
 
-			costToReach, _ := cm.Get(nx)
+			newCostToReach := currCost + weightOf(currWrapper.curr, e)
 
-			if costToReach > currCost+e.weight {
-				newCostToReach := currCost + e.weight
+			if isCheaper(cm, nx, newCostToReach) {
 				cm.Put(nx, newCostToReach)
 				pm.Put(nx, currWrapper.curr)
-				q.Add(&nodeWrapper[T]{curr: nx, costToReach: newCostToReach})
+				q.Add(&nodeWrapper[T, W]{curr: nx, costToReach: newCostToReach})
 			}
 		}
 	}
 
-	cm := gmap.NewHashMap[*Node[T], int64]()
-	pm := gmap.NewHashMap[*Node[T], *Node[T]]()
+	cm := gmap.NewHashMap[*Node[T, W], W]()
+	pm := gmap.NewHashMap[*Node[T, W], *Node[T, W]]()
 
-	q := queue.NewPriorityQueue[*nodeWrapper[T]](false, &nodeComparator[T]{})
+	q := queue.NewPriorityQueue[*nodeWrapper[T, W]](false, &nodeComparator[T, W]{})
 
-	it := lg.nodes.Iterator()
+	it := g.Nodes()
 	for it.HasNext() {
 		n, _ := it.Next()
 		pm.Put(n, nil)
-
-		if n == source {
-			cm.Put(n, 0)
-			q.Add(&nodeWrapper[T]{curr: n, costToReach: 0})
-		} else {
-			cm.Put(n, math.MaxInt64)
-			q.Add(&nodeWrapper[T]{curr: n, costToReach: math.MaxInt64})
-		}
 	}
 
-	relaxedNodes := set.NewHashSet[*Node[T]]()
+	cm.Put(source, internal.Zero)
+	q.Add(&nodeWrapper[T, W]{curr: source, costToReach: internal.Zero})
+
+	relaxedNodes := set.NewHashSet[*Node[T, W]]()
 
 	for !q.Empty() {
 		n, _ := q.Remove()
@@ -807,50 +1036,37 @@ func dijkstra[T any](source, target *Node[T], lg *listGraph[T]) (list.List[*Node
 		relaxedNodes.Add(n.curr)
 	}
 
-	curr := target
-	if !pm.ContainsKey(target) || first(cm.Get(target)) == math.MaxInt64 {
-		//TODO: REFACTOR OPERATION
-		return nil, pathNotFoundError(source.data, target.data, "dijkstra")
-	}
+	return newShortestPathTree(source, cm, pm)
+}
 
-	res := list.NewLinkedList[*Node[T]]()
+func bellmenFord[T any, W Weight](source *Node[T, W], g Graph[T, W]) (*ShortestPathTree[T, W], error) {
+	cm := gmap.NewHashMap[*Node[T, W], W]()
+	pm := gmap.NewHashMap[*Node[T, W], *Node[T, W]]()
 
-	for pm.ContainsKey(curr) {
-		res.AddFirst(curr)
+	nIt := g.Nodes()
+	for nIt.HasNext() {
+		n, _ := nIt.Next()
+		pm.Put(n, nil)
+	}
 
-		if curr == source {
-			break
-		}
+	cm.Put(source, internal.Zero)
 
-		par, err := pm.Get(curr)
-		if err != nil {
-			//TODO: REFACTOR OPERATION
-			return nil, pathNotFoundError(source.data, target.data, "dijkstra")
-		}
-
-		curr = par
+	if relaxEdges(g, cm, pm) != nil {
+		return nil, negativeCycleError("bellmenFord")
 	}
 
-	return res, nil
+	return newShortestPathTree(source, cm, pm), nil
 }
 
-func bellmenFord[T any](source, target *Node[T], lg *listGraph[T]) (list.List[*Node[T]], error) {
-	cm := gmap.NewHashMap[*Node[T], int64]()
-	pm := gmap.NewHashMap[*Node[T], *Node[T]]()
-
-	edges := gmap.NewHashMap[*edge[T], *Node[T]]()
+// relaxEdges returns a node whose cost can still be reduced after all the rounds,
+// such a node is reachable from a negative weight cycle.
+func relaxEdges[T any, W Weight](g Graph[T, W], cm gmap.Map[*Node[T, W], W], pm gmap.Map[*Node[T, W], *Node[T, W]]) *Node[T, W] {
+	edges := gmap.NewHashMap[*edge[T, W], *Node[T, W]]()
 
 	//INEFFICIENT
-	nIt := lg.nodes.Iterator()
+	nIt := g.Nodes()
 	for nIt.HasNext() {
 		n, _ := nIt.Next()
-		pm.Put(n, nil)
-
-		if n == source {
-			cm.Put(n, 0)
-		} else {
-			cm.Put(n, math.MaxInt64)
-		}
 
 		eIt := n.edges.Iterator()
 		for eIt.HasNext() {
@@ -859,7 +1075,7 @@ func bellmenFord[T any](source, target *Node[T], lg *listGraph[T]) (list.List[*N
 		}
 	}
 
-	nIt = lg.nodes.Iterator()
+	nIt = g.Nodes()
 	for nIt.HasNext() {
 		_, _ = nIt.Next()
 
@@ -869,12 +1085,13 @@ func bellmenFord[T any](source, target *Node[T], lg *listGraph[T]) (list.List[*N
 			edge := p.First()
 			source := p.Second()
 
-			if first(cm.Get(source)) == math.MaxInt64 {
+			cost, err := cm.Get(source)
+			if err != nil {
 				continue
 			}
 
-			if first(cm.Get(edge.next)) > first(cm.Get(source))+edge.weight {
-				cm.Put(edge.next, first(cm.Get(source))+edge.weight)
+			if isCheaper(cm, edge.next, cost+edge.weight) {
+				cm.Put(edge.next, cost+edge.weight)
 				pm.Put(edge.next, source)
 			}
 
@@ -887,43 +1104,19 @@ func bellmenFord[T any](source, target *Node[T], lg *listGraph[T]) (list.List[*N
 		edge := p.First()
 		source := p.Second()
 
-		if first(cm.Get(source)) == math.MaxInt64 {
+		cost, err := cm.Get(source)
+		if err != nil {
 			continue
 		}
 
-		if first(cm.Get(edge.next)) > first(cm.Get(source))+edge.weight {
-			return nil, erx.WithArgs(erx.Operation("bellmenFord"), errors.New("graph has negative weight cycle"))
+		if isCheaper(cm, edge.next, cost+edge.weight) {
+			pm.Put(edge.next, source)
+			return edge.next
 		}
 
 	}
 
-	curr := target
-	if !pm.ContainsKey(target) || first(cm.Get(target)) == math.MaxInt64 {
-		//TODO: REFACTOR OPERATION
-		return nil, pathNotFoundError(source.data, target.data, "bellmenFord")
-	}
-
-	res := list.NewLinkedList[*Node[T]]()
-
-	for pm.ContainsKey(curr) {
-
-		res.AddFirst(curr)
-
-		if curr == source {
-			break
-		}
-
-		par, err := pm.Get(curr)
-		if err != nil {
-			//TODO: REFACTOR OPERATION
-			return nil, pathNotFoundError(source.data, target.data, "bellmenFord")
-		}
-
-		curr = par
-	}
-
-	return res, nil
-
+	return nil
 }
 
 func first[T any, E any](first T, second E) T {
@@ -17,8 +17,8 @@ import (
 func TestCreateNewListGraph(t *testing.T) {
 	actual := NewListGraph[int]()
 
-	expected := &listGraph[int]{
-		nodes: set.NewHashSet[*Node[int]](),
+	expected := &listGraph[int, int64]{
+		nodes: set.NewHashSet[*Node[int, int64]](),
 	}
 
 	assert.Equal(t, expected, actual)
@@ -30,14 +30,14 @@ func TestListGraphAddNode(t *testing.T) {
 	for i := 0; i < math.MaxInt8; i++ {
 		n := NewNode[int](i)
 		g.AddNode(n)
-		assert.True(t, g.(*listGraph[int]).nodes.Contains(n))
+		assert.True(t, g.(*listGraph[int, int64]).nodes.Contains(n))
 	}
 }
 
 func TestListGraphCreateDiEdgesSuccess(t *testing.T) {
 	g := NewListGraph[int]()
 
-	nodes := make([]*Node[int], 10)
+	nodes := make([]*Node[int, int64], 10)
 	for i := 0; i < 10; i++ {
 		nodes[i] = NewNode[int](i)
 		g.AddNode(nodes[i])
@@ -70,7 +70,7 @@ func TestListGraphCreateDiEdgesFailure(t *testing.T) {
 func TestListGraphCreateWeightedDiEdgeSuccess(t *testing.T) {
 	g := NewListGraph[int]()
 
-	nodes := make([]*Node[int], 10)
+	nodes := make([]*Node[int, int64], 10)
 	for i := 0; i < 10; i++ {
 		nodes[i] = NewNode[int](i)
 		g.AddNode(nodes[i])
@@ -103,7 +103,7 @@ func TestListGraphCreateWeightedDiEdgeFailure(t *testing.T) {
 func TestListGraphCreateBiEdgesSuccess(t *testing.T) {
 	g := NewListGraph[int]()
 
-	nodes := make([]*Node[int], 10)
+	nodes := make([]*Node[int, int64], 10)
 	for i := 0; i < 10; i++ {
 		nodes[i] = NewNode[int](i)
 		g.AddNode(nodes[i])
@@ -136,7 +136,7 @@ func TestListGraphCreateBiEdgesFailure(t *testing.T) {
 func TestListGraphCreateWeightedBiEdgeSuccess(t *testing.T) {
 	g := NewListGraph[int]()
 
-	nodes := make([]*Node[int], 10)
+	nodes := make([]*Node[int, int64], 10)
 	for i := 0; i < 10; i++ {
 		nodes[i] = NewNode[int](i)
 		g.AddNode(nodes[i])
@@ -191,7 +191,7 @@ func TestListGraphDeleteNodeSuccessWhenNoEdgesArePresent(t *testing.T) {
 	require.NoError(t, g.CreateDiEdge(e, a))
 
 	assert.NoError(t, g.DeleteNode(a))
-	assert.False(t, g.(*listGraph[int]).nodes.Contains(a))
+	assert.False(t, g.(*listGraph[int, int64]).nodes.Contains(a))
 
 	ed, err := b.findEdge(a)
 	assert.Nil(t, ed)
@@ -216,11 +216,11 @@ func TestListGraphDeleteNodeSuccessWithMultipleEdges(t *testing.T) {
 	a := NewNode[int](1)
 
 	g.AddNode(a)
-	assert.True(t, g.(*listGraph[int]).nodes.Contains(a))
+	assert.True(t, g.(*listGraph[int, int64]).nodes.Contains(a))
 
 	err := g.DeleteNode(a)
 	assert.NoError(t, err)
-	assert.False(t, g.(*listGraph[int]).nodes.Contains(a))
+	assert.False(t, g.(*listGraph[int, int64]).nodes.Contains(a))
 }
 
 func TestListGraphDeleteNodeFailure(t *testing.T) {
@@ -228,7 +228,7 @@ func TestListGraphDeleteNodeFailure(t *testing.T) {
 
 	a := NewNode[int](1)
 
-	assert.False(t, g.(*listGraph[int]).nodes.Contains(a))
+	assert.False(t, g.(*listGraph[int, int64]).nodes.Contains(a))
 
 	err := g.DeleteNode(a)
 	internal.AssertErrorEquals(t, errors.New("failed to remove node from graph: set is empty"), err)
@@ -435,29 +435,29 @@ func TestListGraphDfsIteratorNext(t *testing.T) {
 
 func TestListGraphReverse(t *testing.T) {
 	testCases := map[string]struct {
-		actualRes   func() Graph[int]
-		expectedRes func() Graph[int]
+		actualRes   func() Graph[int, int64]
+		expectedRes func() Graph[int, int64]
 	}{
 		"should not fail reverse when graph is empty": {
-			actualRes: func() Graph[int] {
+			actualRes: func() Graph[int, int64] {
 				g := NewListGraph[int]()
 				g.Reverse()
 
 				return g
 			},
-			expectedRes: func() Graph[int] {
+			expectedRes: func() Graph[int, int64] {
 				return NewListGraph[int]()
 			},
 		},
 		"should reverse graph with one node": {
-			actualRes: func() Graph[int] {
+			actualRes: func() Graph[int, int64] {
 				g := NewListGraph[int]()
 				g.AddNode(NewNode[int](1))
 				g.Reverse()
 
 				return g
 			},
-			expectedRes: func() Graph[int] {
+			expectedRes: func() Graph[int, int64] {
 				g := NewListGraph[int]()
 				g.AddNode(NewNode[int](1))
 
@@ -465,39 +465,39 @@ func TestListGraphReverse(t *testing.T) {
 			},
 		},
 		"should reverse graph with multiple nodes": {
-			actualRes: func() Graph[int] {
+			actualRes: func() Graph[int, int64] {
 				g, _ := graphOne()
 				g.Reverse()
 
 				return g
 			},
-			expectedRes: func() Graph[int] {
+			expectedRes: func() Graph[int, int64] {
 				g, _ := graphOneReverse()
 
 				return g
 			},
 		},
 		"should reverse undirected graph produce same graph": {
-			actualRes: func() Graph[int] {
+			actualRes: func() Graph[int, int64] {
 				g, _ := graphNine()
 				g.Reverse()
 
 				return g
 			},
-			expectedRes: func() Graph[int] {
+			expectedRes: func() Graph[int, int64] {
 				g, _ := graphNine()
 
 				return g
 			},
 		},
 		"should reverse disconnected graph": {
-			actualRes: func() Graph[int] {
+			actualRes: func() Graph[int, int64] {
 				g, _ := graphTwentyFive()
 				g.Reverse()
 
 				return g
 			},
-			expectedRes: func() Graph[int] {
+			expectedRes: func() Graph[int, int64] {
 				g, _ := graphTwentyFiveReverse()
 
 				return g
@@ -507,8 +507,8 @@ func TestListGraphReverse(t *testing.T) {
 
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
-			eg := simplifyGraph(testCase.expectedRes().(*listGraph[int]))
-			ag := simplifyGraph(testCase.actualRes().(*listGraph[int]))
+			eg := simplifyGraph(testCase.expectedRes())
+			ag := simplifyGraph(testCase.actualRes())
 			assert.True(t, internal.AreMapsSame[int, []int](eg, ag, intSliceComparator{}))
 		})
 	}
@@ -956,19 +956,19 @@ func TestListGraphIsConnected(t *testing.T) {
 
 func TestListGraphGetConnectedComponents(t *testing.T) {
 	testCases := map[string]struct {
-		actualResult   func() []list.List[*Node[int]]
-		expectedResult func() []list.List[*Node[int]]
+		actualResult   func() []list.List[*Node[int, int64]]
+		expectedResult func() []list.List[*Node[int, int64]]
 	}{
 		"get empty list when graph is empty": {
-			actualResult: func() []list.List[*Node[int]] {
+			actualResult: func() []list.List[*Node[int, int64]] {
 				return NewListGraph[int]().GetConnectedComponents()
 			},
-			expectedResult: func() []list.List[*Node[int]] {
-				return []list.List[*Node[int]]{}
+			expectedResult: func() []list.List[*Node[int, int64]] {
+				return []list.List[*Node[int, int64]]{}
 			},
 		},
 		"get individual nodes when graph has not edges": {
-			actualResult: func() []list.List[*Node[int]] {
+			actualResult: func() []list.List[*Node[int, int64]] {
 				g := NewListGraph[int]()
 
 				for i := 0; i < 10; i++ {
@@ -977,30 +977,30 @@ func TestListGraphGetConnectedComponents(t *testing.T) {
 
 				return g.GetConnectedComponents()
 			},
-			expectedResult: func() []list.List[*Node[int]] {
-				var res []list.List[*Node[int]]
+			expectedResult: func() []list.List[*Node[int, int64]] {
+				var res []list.List[*Node[int, int64]]
 				for i := 9; i >= 0; i-- {
-					res = append(res, list.NewArrayList[*Node[int]](NewNode[int](i)))
+					res = append(res, list.NewArrayList[*Node[int, int64]](NewNode[int](i)))
 				}
 				return res
 			},
 		},
 		"get connected component scenario one": {
-			actualResult: func() []list.List[*Node[int]] {
+			actualResult: func() []list.List[*Node[int, int64]] {
 				g, _ := graphFour()
 				return g.GetConnectedComponents()
 			},
-			expectedResult: func() []list.List[*Node[int]] {
+			expectedResult: func() []list.List[*Node[int, int64]] {
 
-				addData := func(res *[]list.List[*Node[int]], elements ...int) {
-					temp := list.NewArrayList[*Node[int]]()
+				addData := func(res *[]list.List[*Node[int, int64]], elements ...int) {
+					temp := list.NewArrayList[*Node[int, int64]]()
 					for _, element := range elements {
 						temp.Add(NewNode[int](element))
 					}
 					*res = append(*res, temp)
 				}
 
-				var res []list.List[*Node[int]]
+				var res []list.List[*Node[int, int64]]
 				addData(&res, 0)
 				addData(&res, 5)
 				addData(&res, 3, 2, 1)
@@ -1009,21 +1009,21 @@ func TestListGraphGetConnectedComponents(t *testing.T) {
 			},
 		},
 		"get connected component scenario two": {
-			actualResult: func() []list.List[*Node[int]] {
+			actualResult: func() []list.List[*Node[int, int64]] {
 				g, _ := graphTwentyTwo()
 				return g.GetConnectedComponents()
 			},
-			expectedResult: func() []list.List[*Node[int]] {
+			expectedResult: func() []list.List[*Node[int, int64]] {
 
-				addData := func(res *[]list.List[*Node[int]], elements ...int) {
-					temp := list.NewArrayList[*Node[int]]()
+				addData := func(res *[]list.List[*Node[int, int64]], elements ...int) {
+					temp := list.NewArrayList[*Node[int, int64]]()
 					for _, element := range elements {
 						temp.Add(NewNode[int](element))
 					}
 					*res = append(*res, temp)
 				}
 
-				var res []list.List[*Node[int]]
+				var res []list.List[*Node[int, int64]]
 				addData(&res, 0)
 				addData(&res, 3)
 				addData(&res, 4)
@@ -1034,21 +1034,21 @@ func TestListGraphGetConnectedComponents(t *testing.T) {
 			},
 		},
 		"get connected component scenario three": {
-			actualResult: func() []list.List[*Node[int]] {
+			actualResult: func() []list.List[*Node[int, int64]] {
 				g, _ := graphTwentyFour()
 				return g.GetConnectedComponents()
 			},
-			expectedResult: func() []list.List[*Node[int]] {
+			expectedResult: func() []list.List[*Node[int, int64]] {
 
-				addData := func(res *[]list.List[*Node[int]], elements ...int) {
-					temp := list.NewArrayList[*Node[int]]()
+				addData := func(res *[]list.List[*Node[int, int64]], elements ...int) {
+					temp := list.NewArrayList[*Node[int, int64]]()
 					for _, element := range elements {
 						temp.Add(NewNode[int](element))
 					}
 					*res = append(*res, temp)
 				}
 
-				var res []list.List[*Node[int]]
+				var res []list.List[*Node[int, int64]]
 				addData(&res, 12, 14, 13, 11, 10)
 				addData(&res, 9, 8)
 				addData(&res, 3, 5, 6, 7, 4, 2, 1, 0)
@@ -1065,8 +1065,8 @@ func TestListGraphGetConnectedComponents(t *testing.T) {
 }
 
 func TestListGraphUnWeightedGraphShortestPath(t *testing.T) {
-	toList := func(data ...int) list.List[*Node[int]] {
-		res := list.NewLinkedList[*Node[int]]()
+	toList := func(data ...int) list.List[*Node[int, int64]] {
+		res := list.NewLinkedList[*Node[int, int64]]()
 		for _, e := range data {
 			res.Add(NewNode[int](e))
 		}
@@ -1074,12 +1074,12 @@ func TestListGraphUnWeightedGraphShortestPath(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		actualResult   func() (list.List[*Node[int]], error)
-		expectedResult list.List[*Node[int]]
+		actualResult   func() (list.List[*Node[int, int64]], error)
+		expectedResult list.List[*Node[int, int64]]
 		expectedError  error
 	}{
 		"should return shortest path for unweighted graph scenario one": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphOne()
 				a := getNodeWithVal(g, 6)
 				b := getNodeWithVal(g, 4)
@@ -1088,7 +1088,7 @@ func TestListGraphUnWeightedGraphShortestPath(t *testing.T) {
 			expectedResult: toList(6, 5, 4),
 		},
 		"should return shortest path for unweighted graph scenario two": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphOne()
 				a := getNodeWithVal(g, 6)
 				b := getNodeWithVal(g, 4)
@@ -1097,7 +1097,7 @@ func TestListGraphUnWeightedGraphShortestPath(t *testing.T) {
 			expectedResult: toList(4, 6),
 		},
 		"should return shortest path for unweighted graph scenario three": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphFour()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 2)
@@ -1106,7 +1106,7 @@ func TestListGraphUnWeightedGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 1, 3, 2),
 		},
 		"should return shortest path for unweighted graph scenario four": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyFour()
 				a := getNodeWithVal(g, 5)
 				b := getNodeWithVal(g, 6)
@@ -1115,7 +1115,7 @@ func TestListGraphUnWeightedGraphShortestPath(t *testing.T) {
 			expectedResult: toList(5, 1, 2, 6),
 		},
 		"should return error when no path exists between source and target scenario one": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyFour()
 				a := getNodeWithVal(g, 7)
 				b := getNodeWithVal(g, 11)
@@ -1124,7 +1124,7 @@ func TestListGraphUnWeightedGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 7 to 11 not found in the graph"),
 		},
 		"should return error when no path exists between source and target scenario two": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphFour()
 				a := getNodeWithVal(g, 2)
 				b := getNodeWithVal(g, 0)
@@ -1133,7 +1133,7 @@ func TestListGraphUnWeightedGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 2 to 0 not found in the graph"),
 		},
 		"should return error source vertex is not present in the graph": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g := NewListGraph[int]()
 				a := NewNode[int](1)
 				b := NewNode[int](2)
@@ -1143,7 +1143,7 @@ func TestListGraphUnWeightedGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("node 1 not found in the graph"),
 		},
 		"should return error target vertex is not present in the graph": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g := NewListGraph[int]()
 				a := NewNode[int](1)
 				b := NewNode[int](2)
@@ -1166,8 +1166,8 @@ func TestListGraphUnWeightedGraphShortestPath(t *testing.T) {
 }
 
 func TestListGraphDirectedAcyclicGraphShortestPath(t *testing.T) {
-	toList := func(data ...int) list.List[*Node[int]] {
-		res := list.NewLinkedList[*Node[int]]()
+	toList := func(data ...int) list.List[*Node[int, int64]] {
+		res := list.NewLinkedList[*Node[int, int64]]()
 		for _, e := range data {
 			res.Add(NewNode[int](e))
 		}
@@ -1175,12 +1175,12 @@ func TestListGraphDirectedAcyclicGraphShortestPath(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		actualResult   func() (list.List[*Node[int]], error)
-		expectedResult list.List[*Node[int]]
+		actualResult   func() (list.List[*Node[int, int64]], error)
+		expectedResult list.List[*Node[int, int64]]
 		expectedError  error
 	}{
 		"should return shortest path for dag scenario one": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphNineTeen()
 				a := getNodeWithVal(g, 4)
 				b := getNodeWithVal(g, 5)
@@ -1189,7 +1189,7 @@ func TestListGraphDirectedAcyclicGraphShortestPath(t *testing.T) {
 			expectedResult: toList(4, 6, 7, 5),
 		},
 		"should return shortest path for dag scenario two": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphNineTeen()
 				a := getNodeWithVal(g, 7)
 				b := getNodeWithVal(g, 5)
@@ -1198,7 +1198,7 @@ func TestListGraphDirectedAcyclicGraphShortestPath(t *testing.T) {
 			expectedResult: toList(7, 5),
 		},
 		"should return shortest path for dag scenario three": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyOne()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 2)
@@ -1207,7 +1207,7 @@ func TestListGraphDirectedAcyclicGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 1, 3, 2),
 		},
 		"should return shortest path for dag scenario four": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyTwo()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 2)
@@ -1216,7 +1216,7 @@ func TestListGraphDirectedAcyclicGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 3, 2),
 		},
 		"should return error when no path exists between source and target scenario one": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyTwo()
 				a := getNodeWithVal(g, 5)
 				b := getNodeWithVal(g, 4)
@@ -1225,7 +1225,7 @@ func TestListGraphDirectedAcyclicGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 5 to 4 not found in the graph"),
 		},
 		"should return error when no path exists between source and target scenario two": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyOne()
 				a := getNodeWithVal(g, 3)
 				b := getNodeWithVal(g, 1)
@@ -1234,7 +1234,7 @@ func TestListGraphDirectedAcyclicGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 3 to 1 not found in the graph"),
 		},
 		"should return error source vertex is not present in the graph": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g := NewListGraph[int]()
 				a := NewNode[int](1)
 				b := NewNode[int](2)
@@ -1244,7 +1244,7 @@ func TestListGraphDirectedAcyclicGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("node 1 not found in the graph"),
 		},
 		"should return error target vertex is not present in the graph": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g := NewListGraph[int]()
 				a := NewNode[int](1)
 				b := NewNode[int](2)
@@ -1267,8 +1267,8 @@ func TestListGraphDirectedAcyclicGraphShortestPath(t *testing.T) {
 }
 
 func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
-	toList := func(data ...int) list.List[*Node[int]] {
-		res := list.NewLinkedList[*Node[int]]()
+	toList := func(data ...int) list.List[*Node[int, int64]] {
+		res := list.NewLinkedList[*Node[int, int64]]()
 		for _, e := range data {
 			res.Add(NewNode[int](e))
 		}
@@ -1276,12 +1276,12 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		actualResult   func() (list.List[*Node[int]], error)
-		expectedResult list.List[*Node[int]]
+		actualResult   func() (list.List[*Node[int, int64]], error)
+		expectedResult list.List[*Node[int, int64]]
 		expectedError  error
 	}{
 		"should return shortest path for non negative graph scenario one": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphOne()
 				a := getNodeWithVal(g, 6)
 				b := getNodeWithVal(g, 4)
@@ -1290,7 +1290,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedResult: toList(6, 5, 4),
 		},
 		"should return shortest path for non negative graph scenario two": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphOne()
 				a := getNodeWithVal(g, 6)
 				b := getNodeWithVal(g, 4)
@@ -1299,7 +1299,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedResult: toList(4, 6),
 		},
 		"should return shortest path for non negative graph scenario three": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphFour()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 2)
@@ -1308,7 +1308,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 1, 3, 2),
 		},
 		"should return shortest path for non negative graph scenario four": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyFour()
 				a := getNodeWithVal(g, 5)
 				b := getNodeWithVal(g, 6)
@@ -1317,7 +1317,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedResult: toList(5, 1, 2, 6),
 		},
 		"should return shortest path for non negative graph scenario five": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphNineTeen()
 				a := getNodeWithVal(g, 4)
 				b := getNodeWithVal(g, 5)
@@ -1326,7 +1326,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedResult: toList(4, 6, 7, 5),
 		},
 		"should return shortest path for non negative graph scenario six": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphNineTeen()
 				a := getNodeWithVal(g, 7)
 				b := getNodeWithVal(g, 5)
@@ -1335,7 +1335,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedResult: toList(7, 5),
 		},
 		"should return shortest path for non negative graph scenario seven": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyOne()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 2)
@@ -1344,7 +1344,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 1, 3, 2),
 		},
 		"should return shortest path for non negative graph scenario eight": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyTwo()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 2)
@@ -1353,7 +1353,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 3, 2),
 		},
 		"should return shortest path for non negative graph scenario nine": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphEighteen()
 				a := getNodeWithVal(g, 5)
 				b := getNodeWithVal(g, 1)
@@ -1362,7 +1362,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedResult: toList(5, 3, 2, 1),
 		},
 		"should return shortest path for non negative graph scenario ten": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyFive()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 4)
@@ -1371,7 +1371,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 1, 2, 6, 7, 4),
 		},
 		"should return error when no path exists between source and target scenario one": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyTwo()
 				a := getNodeWithVal(g, 5)
 				b := getNodeWithVal(g, 4)
@@ -1380,7 +1380,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 5 to 4 not found in the graph"),
 		},
 		"should return error when no path exists between source and target scenario two": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyOne()
 				a := getNodeWithVal(g, 3)
 				b := getNodeWithVal(g, 1)
@@ -1389,7 +1389,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 3 to 1 not found in the graph"),
 		},
 		"should return error when no path exists between source and target scenario three": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyFour()
 				a := getNodeWithVal(g, 7)
 				b := getNodeWithVal(g, 11)
@@ -1398,7 +1398,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 7 to 11 not found in the graph"),
 		},
 		"should return error when no path exists between source and target scenario four": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphFour()
 				a := getNodeWithVal(g, 2)
 				b := getNodeWithVal(g, 0)
@@ -1407,7 +1407,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 2 to 0 not found in the graph"),
 		},
 		"should return error source vertex is not present in the graph": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g := NewListGraph[int]()
 				a := NewNode[int](1)
 				b := NewNode[int](2)
@@ -1417,7 +1417,7 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("node 1 not found in the graph"),
 		},
 		"should return error target vertex is not present in the graph": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g := NewListGraph[int]()
 				a := NewNode[int](1)
 				b := NewNode[int](2)
@@ -1440,8 +1440,8 @@ func TestListGraphNonNegativeWeightGraphShortestPath(t *testing.T) {
 }
 
 func TestListGraphGraphShortestPath(t *testing.T) {
-	toList := func(data ...int) list.List[*Node[int]] {
-		res := list.NewLinkedList[*Node[int]]()
+	toList := func(data ...int) list.List[*Node[int, int64]] {
+		res := list.NewLinkedList[*Node[int, int64]]()
 		for _, e := range data {
 			res.Add(NewNode[int](e))
 		}
@@ -1449,12 +1449,12 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		actualResult   func() (list.List[*Node[int]], error)
-		expectedResult list.List[*Node[int]]
+		actualResult   func() (list.List[*Node[int, int64]], error)
+		expectedResult list.List[*Node[int, int64]]
 		expectedError  error
 	}{
 		"should return shortest path for graph scenario one": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphOne()
 				a := getNodeWithVal(g, 6)
 				b := getNodeWithVal(g, 4)
@@ -1463,7 +1463,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedResult: toList(6, 5, 4),
 		},
 		"should return shortest path for graph scenario two": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphOne()
 				a := getNodeWithVal(g, 6)
 				b := getNodeWithVal(g, 4)
@@ -1472,7 +1472,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedResult: toList(4, 6),
 		},
 		"should return shortest path for graph scenario three": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphFour()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 2)
@@ -1481,7 +1481,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 1, 3, 2),
 		},
 		"should return shortest path for graph scenario four": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyFour()
 				a := getNodeWithVal(g, 5)
 				b := getNodeWithVal(g, 6)
@@ -1490,7 +1490,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedResult: toList(5, 1, 2, 6),
 		},
 		"should return shortest path for graph scenario five": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphNineTeen()
 				a := getNodeWithVal(g, 4)
 				b := getNodeWithVal(g, 5)
@@ -1499,7 +1499,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedResult: toList(4, 6, 7, 5),
 		},
 		"should return shortest path for graph scenario six": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphNineTeen()
 				a := getNodeWithVal(g, 7)
 				b := getNodeWithVal(g, 5)
@@ -1508,7 +1508,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedResult: toList(7, 5),
 		},
 		"should return shortest path for graph scenario seven": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyOne()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 2)
@@ -1517,7 +1517,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 1, 3, 2),
 		},
 		"should return shortest path for graph scenario eight": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyTwo()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 2)
@@ -1526,7 +1526,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 3, 2),
 		},
 		"should return shortest path for graph scenario nine": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphEighteen()
 				a := getNodeWithVal(g, 5)
 				b := getNodeWithVal(g, 1)
@@ -1535,7 +1535,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedResult: toList(5, 3, 2, 1),
 		},
 		"should return shortest path for graph scenario ten": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyFive()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 4)
@@ -1544,7 +1544,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedResult: toList(0, 1, 2, 6, 7, 4),
 		},
 		"should return error when no path exists between source and target scenario one": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyTwo()
 				a := getNodeWithVal(g, 5)
 				b := getNodeWithVal(g, 4)
@@ -1553,7 +1553,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 5 to 4 not found in the graph"),
 		},
 		"should return error when no path exists between source and target scenario two": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyOne()
 				a := getNodeWithVal(g, 3)
 				b := getNodeWithVal(g, 1)
@@ -1562,7 +1562,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 3 to 1 not found in the graph"),
 		},
 		"should return error when no path exists between source and target scenario three": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyFour()
 				a := getNodeWithVal(g, 7)
 				b := getNodeWithVal(g, 11)
@@ -1571,7 +1571,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 7 to 11 not found in the graph"),
 		},
 		"should return error when no path exists between source and target scenario four": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphFour()
 				a := getNodeWithVal(g, 2)
 				b := getNodeWithVal(g, 0)
@@ -1580,7 +1580,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("path 2 to 0 not found in the graph"),
 		},
 		"should return error when graph has negative weight cycle": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g, _ := graphTwentyThree()
 				a := getNodeWithVal(g, 0)
 				b := getNodeWithVal(g, 4)
@@ -1589,7 +1589,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("graph has negative weight cycle"),
 		},
 		"should return error source vertex is not present in the graph": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g := NewListGraph[int]()
 				a := NewNode[int](1)
 				b := NewNode[int](2)
@@ -1599,7 +1599,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 			expectedError: errors.New("node 1 not found in the graph"),
 		},
 		"should return error target vertex is not present in the graph": {
-			actualResult: func() (list.List[*Node[int]], error) {
+			actualResult: func() (list.List[*Node[int, int64]], error) {
 				g := NewListGraph[int]()
 				a := NewNode[int](1)
 				b := NewNode[int](2)
@@ -1621,7 +1621,7 @@ func TestListGraphGraphShortestPath(t *testing.T) {
 	}
 }
 
-func isListEqual(a, b list.List[*Node[int]]) bool {
+func isListEqual(a, b list.List[*Node[int, int64]]) bool {
 	if a == nil && b == nil {
 		return true
 	}
@@ -1652,7 +1652,7 @@ func isListEqual(a, b list.List[*Node[int]]) bool {
 	return true
 }
 
-func printList(l list.List[*Node[int]]) {
+func printList(l list.List[*Node[int, int64]]) {
 	it := l.Iterator()
 	for it.HasNext() {
 		v, _ := it.Next()
@@ -1660,3 +1660,355 @@ func printList(l list.List[*Node[int]]) {
 	}
 	fmt.Println()
 }
+
+func TestListGraphNodes(t *testing.T) {
+	g, _ := graphFour()
+
+	res := make([]int, 0)
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		res = append(res, n.Data())
+	}
+
+	assert.ElementsMatch(t, []int{0, 1, 2, 3, 4, 5}, res)
+	assert.False(t, NewListGraph[int]().Nodes().HasNext())
+}
+
+func TestListGraphEdges(t *testing.T) {
+	type edgeData struct {
+		from, to int
+		weight   int64
+	}
+
+	g, _ := graphTwenty()
+
+	res := make([]edgeData, 0)
+
+	it := g.Edges()
+	for it.HasNext() {
+		e, err := it.Next()
+		require.NoError(t, err)
+		res = append(res, edgeData{from: e.From().Data(), to: e.To().Data(), weight: e.Weight()})
+	}
+
+	assert.ElementsMatch(t, []edgeData{{0, 1, 2}, {1, 2, 4}, {1, 3, 8}, {2, 3, 3}}, res)
+
+	_, err := it.Next()
+	internal.AssertErrorEquals(t, errors.New("iterator is empty"), err)
+
+	empty := NewListGraph[int]()
+	empty.AddNode(NewNode[int](1))
+	assert.False(t, empty.Edges().HasNext())
+}
+
+func TestListGraphEdgeWeight(t *testing.T) {
+	g, _ := graphTwenty()
+
+	w, err := g.EdgeWeight(getNodeWithVal(g, 1), getNodeWithVal(g, 3))
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), w)
+
+	_, err = g.EdgeWeight(getNodeWithVal(g, 3), getNodeWithVal(g, 1))
+	internal.AssertErrorEquals(t, errors.New("edge 3 to 1 not found in the graph"), err)
+
+	_, err = g.EdgeWeight(getNodeWithVal(g, 1), NewNode[int](9))
+	internal.AssertErrorEquals(t, errors.New("node 9 not found in the graph"), err)
+}
+
+func pathWeight(g Graph[int, int64], path list.List[*Node[int, int64]]) (int64, error) {
+	var res int64
+
+	it := path.Iterator()
+	prev, _ := it.Next()
+
+	for it.HasNext() {
+		curr, _ := it.Next()
+
+		w, err := g.EdgeWeight(prev, curr)
+		if err != nil {
+			return res, err
+		}
+
+		res += w
+		prev = curr
+	}
+
+	return res, nil
+}
+
+func TestListGraphFloatWeights(t *testing.T) {
+	for name, newGraph := range map[string]func() Graph[string, float64]{
+		"list":   NewWeightedListGraph[string, float64],
+		"matrix": NewWeightedMatrixGraph[string, float64],
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := newGraph()
+
+			nodes := make(map[string]*Node[string, float64])
+			for _, d := range []string{"a", "b", "c", "d"} {
+				nodes[d] = NewWeightedNode[string, float64](d)
+				g.AddNode(nodes[d])
+			}
+
+			// the weights only differ after the decimal point, the cheapest route is a b c d.
+			require.NoError(t, g.CreateWeightedDiEdge(nodes["a"], nodes["b"], 0.2))
+			require.NoError(t, g.CreateWeightedDiEdge(nodes["a"], nodes["c"], 0.7))
+			require.NoError(t, g.CreateWeightedDiEdge(nodes["b"], nodes["c"], 0.3))
+			require.NoError(t, g.CreateWeightedDiEdge(nodes["b"], nodes["d"], 0.9))
+			require.NoError(t, g.CreateWeightedDiEdge(nodes["c"], nodes["d"], 0.25))
+
+			toData := func(l list.List[*Node[string, float64]]) []string {
+				res := make([]string, 0)
+
+				it := l.Iterator()
+				for it.HasNext() {
+					n, _ := it.Next()
+					res = append(res, n.Data())
+				}
+
+				return res
+			}
+
+			for _, properties := range [][]Property{{NonNegativeWeights}, {Directed, ACyclic}, {}} {
+				spt, err := g.ShortestPathTree(nodes["a"], properties...)
+				require.NoError(t, err)
+
+				d, err := spt.Distance(nodes["d"])
+				require.NoError(t, err)
+				assert.InDelta(t, 0.75, d, 1e-9)
+
+				pr, err := spt.PathTo(nodes["d"])
+				require.NoError(t, err)
+				assert.Equal(t, []string{"a", "b", "c", "d"}, toData(pr.Nodes()))
+			}
+
+			for _, ap := range []func() (*AllPairsShortestPaths[string, float64], error){g.FloydWarshall, g.Johnson} {
+				res, err := ap()
+				require.NoError(t, err)
+
+				d, err := res.Distance(nodes["a"], nodes["d"])
+				require.NoError(t, err)
+				assert.InDelta(t, 0.75, d, 1e-9)
+
+				_, err = res.Distance(nodes["d"], nodes["a"])
+				internal.AssertErrorEquals(t, errors.New("path d to a not found in the graph"), err)
+			}
+
+			pr, err := g.BidirectionalDijkstra(nodes["a"], nodes["d"])
+			require.NoError(t, err)
+			assert.InDelta(t, 0.75, pr.Weight(), 1e-9)
+
+			fr, err := EdmondsKarp(g, nodes["a"], nodes["d"])
+			require.NoError(t, err)
+			assert.InDelta(t, 0.45, fr.Value(), 1e-9)
+		})
+	}
+}
+
+type meters int32
+
+func TestListGraphNamedWeightType(t *testing.T) {
+	g := NewWeightedListGraph[int, meters]()
+
+	nodes := make([]*Node[int, meters], 3)
+	for i := range nodes {
+		nodes[i] = NewWeightedNode[int, meters](i)
+		g.AddNode(nodes[i])
+	}
+
+	require.NoError(t, g.CreateWeightedBiEdge(nodes[0], nodes[1], 4))
+	require.NoError(t, g.CreateWeightedBiEdge(nodes[1], nodes[2], -1))
+	require.NoError(t, g.CreateWeightedDiEdge(nodes[0], nodes[2], 2))
+
+	w, err := g.EdgeWeight(nodes[1], nodes[2])
+	require.NoError(t, err)
+	assert.Equal(t, meters(-1), w)
+
+	_, weight, err := g.KruskalMST()
+	internal.AssertErrorEquals(t, errors.New("graph is not undirected"), err)
+	assert.Equal(t, meters(0), weight)
+
+	_, err = g.ShortestPathTree(nodes[0])
+	internal.AssertErrorEquals(t, errors.New("graph has negative weight cycle"), err)
+
+	cycle, err := NegativeCycle(g)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), cycle.Size())
+}
+
+func TestLabeledParallelEdges(t *testing.T) {
+	forEachGraphKind(t, testLabeledParallelEdges)
+}
+
+func TestUnlabeledEdgesAreAppended(t *testing.T) {
+	forEachGraphKind(t, testUnlabeledEdgesAreAppended)
+}
+
+func testUnlabeledEdgesAreAppended(t *testing.T, newGraph func() Graph[int, int64]) {
+	g := newGraph()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+
+	createWeightedEdge(g, false, a, b, 5)
+	createWeightedEdge(g, false, a, b, 2)
+
+	edges, err := g.EdgesBetween(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), edges.Size())
+	assert.Equal(t, int64(2), first(g.EdgeWeight(a, b)))
+
+	it := Condensation[int, int64](g).Edges()
+	require.True(t, it.HasNext())
+
+	e, _ := it.Next()
+	assert.Equal(t, int64(2), e.Weight())
+	assert.False(t, it.HasNext())
+}
+
+func testLabeledParallelEdges(t *testing.T, newGraph func() Graph[int, int64]) {
+	g := newGraph()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+
+	require.NoError(t, g.CreateLabeledDiEdge(a, b, "road", 5))
+	require.NoError(t, g.CreateLabeledDiEdge(a, b, "rail", 3))
+	require.NoError(t, g.CreateLabeledBiEdge(b, c, "road", 2))
+
+	internal.AssertErrorEquals(t, errors.New("edge 1 to 2 labeled \"road\" already exists in the graph"), g.CreateLabeledDiEdge(a, b, "road", 7))
+
+	edges, err := g.EdgesBetween(a, b)
+	require.NoError(t, err)
+
+	weights := make(map[string]int64)
+	it := edges.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		weights[e.Label()] = e.Weight()
+	}
+
+	assert.Equal(t, map[string]int64{"road": 5, "rail": 3}, weights)
+
+	w, err := g.EdgeWeight(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), w)
+
+	in, err := g.InDegreeOfNode(b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), in)
+
+	e, err := g.FindEdge(a, b, "road")
+	require.NoError(t, err)
+	e.Attributes()["lanes"] = 2
+
+	e, err = g.FindEdge(a, b, "road")
+	require.NoError(t, err)
+	assert.Equal(t, Attributes{"lanes": 2}, e.Attributes())
+
+	a.Attributes()["name"] = "depot"
+
+	cl := g.Clone()
+	ca := getNodeWithVal(cl, 1)
+	cb := getNodeWithVal(cl, 2)
+
+	assert.Equal(t, Attributes{"name": "depot"}, ca.Attributes())
+
+	ce, err := cl.FindEdge(ca, cb, "road")
+	require.NoError(t, err)
+	assert.Equal(t, Attributes{"lanes": 2}, ce.Attributes())
+
+	ce.Attributes()["lanes"] = 4
+	assert.Equal(t, Attributes{"lanes": 2}, e.Attributes())
+
+	require.NoError(t, g.DeleteLabeledEdge(a, b, "road"))
+
+	_, err = g.FindEdge(a, b, "road")
+	internal.AssertErrorEquals(t, errors.New("edge 1 to 2 labeled \"road\" not found in the graph"), err)
+	internal.AssertErrorEquals(t, errors.New("edge 1 to 2 labeled \"road\" not found in the graph"), g.DeleteLabeledEdge(a, b, "road"))
+
+	ok, err := g.AreAdjacent(a, b)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, g.CreateLabeledDiEdge(a, b, "road", 5))
+	require.NoError(t, g.DeleteEdge(a, b))
+
+	edges, err = g.EdgesBetween(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), edges.Size())
+
+	bridges, err := g.Bridges()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), bridges.Size())
+
+	internal.AssertErrorEquals(t, errors.New("node 4 not found in the graph"), g.CreateLabeledDiEdge(a, NewNode[int](4), "road", 1))
+	internal.AssertErrorEquals(t, errors.New("node 4 not found in the graph"), g.DeleteLabeledEdge(NewNode[int](4), a, "road"))
+
+	_, err = g.EdgesBetween(a, NewNode[int](4))
+	internal.AssertErrorEquals(t, errors.New("node 4 not found in the graph"), err)
+}
+
+func labeledWeights(g Graph[int, int64]) map[string]int64 {
+	res := make(map[string]int64)
+
+	it := g.Edges()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res[fmt.Sprintf("%d>%d:%s", e.From().Data(), e.To().Data(), e.Label())] = e.Weight()
+	}
+
+	return res
+}
+
+// edgeList describes every edge of g, parallel edges with the same label each
+// show up.
+// forEachGraphKind runs test once for every graph kind of the package, starting
+// from the empty graphs newGraph returns.
+func forEachGraphKind(t *testing.T, test func(t *testing.T, newGraph func() Graph[int, int64])) {
+	kinds := []struct {
+		name     string
+		newGraph func() Graph[int, int64]
+	}{
+		{name: "list", newGraph: NewListGraph[int]},
+		{name: "matrix", newGraph: NewMatrixGraph[int]},
+	}
+
+	for _, k := range kinds {
+		t.Run(k.name, func(t *testing.T) {
+			test(t, k.newGraph)
+		})
+	}
+}
+
+func edgeList(g Graph[int, int64]) []string {
+	res := make([]string, 0)
+
+	it := g.Edges()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res = append(res, fmt.Sprintf("%d>%d:%s=%d", e.From().Data(), e.To().Data(), e.Label(), e.Weight()))
+	}
+
+	sort.Strings(res)
+	return res
+}
+
+func nodeData(g Graph[int, int64]) []int {
+	res := make([]int, 0)
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		res = append(res, n.Data())
+	}
+
+	return res
+}
@@ -0,0 +1,527 @@
+package graph
+
+import (
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+)
+
+// matrixGraph keeps the edges of every node in the node itself, same as listGraph,
+// and indexes them in a matrix so that adjacency and weight lookups are O(1). A
+// cell holds every parallel edge between its two nodes.
+// The index uses a builtin map since nodes are compared by identity.
+type matrixGraph[T any, W Weight] struct {
+	nodes   []*Node[T, W]
+	indexes map[*Node[T, W]]int
+	matrix  [][][]*edge[T, W]
+}
+
+func NewMatrixGraph[T any]() Graph[T, int64] {
+	return NewWeightedMatrixGraph[T, int64]()
+}
+
+func NewWeightedMatrixGraph[T any, W Weight]() Graph[T, W] {
+	return &matrixGraph[T, W]{
+		nodes:   make([]*Node[T, W], 0),
+		indexes: make(map[*Node[T, W]]int),
+		matrix:  make([][][]*edge[T, W], 0),
+	}
+}
+
+func (mg *matrixGraph[T, W]) AddNode(n *Node[T, W]) {
+	if mg.Contains(n) {
+		return
+	}
+
+	mg.indexes[n] = len(mg.nodes)
+	mg.nodes = append(mg.nodes, n)
+
+	for i := range mg.matrix {
+		mg.matrix[i] = append(mg.matrix[i], nil)
+	}
+
+	mg.matrix = append(mg.matrix, make([][]*edge[T, W], len(mg.nodes)))
+}
+
+func (mg *matrixGraph[T, W]) CreateDiEdge(curr *Node[T, W], next *Node[T, W]) error {
+	return mg.createEdge(curr, next, "", 0)
+}
+
+func (mg *matrixGraph[T, W]) CreateWeightedDiEdge(curr, next *Node[T, W], weight W) error {
+	return mg.createEdge(curr, next, "", weight)
+}
+
+func (mg *matrixGraph[T, W]) CreateBiEdge(curr *Node[T, W], next *Node[T, W]) error {
+	if err := mg.createEdge(curr, next, "", 0); err != nil {
+		return err
+	}
+
+	return mg.createEdge(next, curr, "", 0)
+}
+
+func (mg *matrixGraph[T, W]) CreateWeightedBiEdge(curr, next *Node[T, W], weight W) error {
+	if err := mg.createEdge(curr, next, "", weight); err != nil {
+		return err
+	}
+
+	return mg.createEdge(next, curr, "", weight)
+}
+
+func (mg *matrixGraph[T, W]) CreateLabeledDiEdge(curr, next *Node[T, W], label string, weight W) error {
+	return mg.createEdge(curr, next, label, weight)
+}
+
+func (mg *matrixGraph[T, W]) CreateLabeledBiEdge(curr, next *Node[T, W], label string, weight W) error {
+	if err := mg.createEdge(curr, next, label, weight); err != nil {
+		return err
+	}
+
+	return mg.createEdge(next, curr, label, weight)
+}
+
+// createEdge adds a new edge to the cell like listGraph does, so edges created
+// without a label between the same nodes are kept side by side and a label
+// cannot be used twice.
+func (mg *matrixGraph[T, W]) createEdge(curr, next *Node[T, W], label string, weight W) error {
+	if !mg.Contains(curr) {
+		return nodeNotFoundError(curr.data, "matrixGraph.createEdge")
+	}
+
+	if !mg.Contains(next) {
+		return nodeNotFoundError(next.data, "matrixGraph.createEdge")
+	}
+
+	i, j := mg.indexes[curr], mg.indexes[next]
+
+	for _, e := range mg.matrix[i][j] {
+		if label != "" && e.label == label {
+			return labeledEdgeExistsError(curr.data, next.data, label, "matrixGraph.createEdge")
+		}
+	}
+
+	mg.link(i, j, newLabeledDiEdge[T, W](next, label, weight))
+	return nil
+}
+
+func (mg *matrixGraph[T, W]) attach(from, to *Node[T, W], e *edge[T, W]) {
+	mg.link(mg.indexes[from], mg.indexes[to], e)
+}
+
+func (mg *matrixGraph[T, W]) link(i, j int, e *edge[T, W]) {
+	mg.nodes[i].addEdge(e)
+	mg.matrix[i][j] = append(mg.matrix[i][j], e)
+}
+
+func (mg *matrixGraph[T, W]) DeleteNode(n *Node[T, W]) error {
+	if !mg.Contains(n) {
+		return nodeNotFoundError(n.data, "matrixGraph.DeleteNode")
+	}
+
+	k := mg.indexes[n]
+
+	for i, v := range mg.nodes {
+		if i == k {
+			continue
+		}
+
+		for _, e := range mg.matrix[i][k] {
+			if err := v.removeEdge(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	mg.matrix = append(mg.matrix[:k], mg.matrix[k+1:]...)
+	for i := range mg.matrix {
+		mg.matrix[i] = append(mg.matrix[i][:k], mg.matrix[i][k+1:]...)
+	}
+
+	mg.nodes = append(mg.nodes[:k], mg.nodes[k+1:]...)
+
+	delete(mg.indexes, n)
+	for i := k; i < len(mg.nodes); i++ {
+		mg.indexes[mg.nodes[i]] = i
+	}
+
+	return nil
+}
+
+func (mg *matrixGraph[T, W]) DeleteEdge(start, end *Node[T, W]) error {
+	if !mg.Contains(start) {
+		return nodeNotFoundError(start.data, "matrixGraph.DeleteEdge")
+	}
+
+	if !mg.Contains(end) {
+		return nodeNotFoundError(end.data, "matrixGraph.DeleteEdge")
+	}
+
+	i, j := mg.indexes[start], mg.indexes[end]
+
+	if len(mg.matrix[i][j]) == 0 {
+		return edgeNotFoundError(start.data, end.data, "matrixGraph.DeleteEdge")
+	}
+
+	for _, e := range mg.matrix[i][j] {
+		if err := start.removeEdge(e); err != nil {
+			return err
+		}
+	}
+
+	mg.matrix[i][j] = nil
+	return nil
+}
+
+func (mg *matrixGraph[T, W]) DeleteLabeledEdge(start, end *Node[T, W], label string) error {
+	if !mg.Contains(start) {
+		return nodeNotFoundError(start.data, "matrixGraph.DeleteLabeledEdge")
+	}
+
+	if !mg.Contains(end) {
+		return nodeNotFoundError(end.data, "matrixGraph.DeleteLabeledEdge")
+	}
+
+	i, j := mg.indexes[start], mg.indexes[end]
+
+	for k, e := range mg.matrix[i][j] {
+		if e.label != label {
+			continue
+		}
+
+		if err := start.removeEdge(e); err != nil {
+			return err
+		}
+
+		mg.matrix[i][j] = append(mg.matrix[i][j][:k], mg.matrix[i][j][k+1:]...)
+		return nil
+	}
+
+	return labeledEdgeNotFoundError(start.data, end.data, label, "matrixGraph.DeleteLabeledEdge")
+}
+
+func (mg *matrixGraph[T, W]) Contains(n *Node[T, W]) bool {
+	_, ok := mg.indexes[n]
+	return ok
+}
+
+func (mg *matrixGraph[T, W]) Nodes() iterator.Iterator[*Node[T, W]] {
+	return list.NewArrayList[*Node[T, W]](mg.nodes...).Iterator()
+}
+
+func (mg *matrixGraph[T, W]) Edges() iterator.Iterator[*Edge[T, W]] {
+	return &graphEdgeIterator[T, W]{nodesIterator: mg.Nodes()}
+}
+
+func (mg *matrixGraph[T, W]) DFSIterator() iterator.Iterator[*Node[T, W]] {
+	return newGraphIterator[T, W](false, mg.Nodes())
+}
+
+func (mg *matrixGraph[T, W]) BFSIterator() iterator.Iterator[*Node[T, W]] {
+	return newGraphIterator[T, W](true, mg.Nodes())
+}
+
+func (mg *matrixGraph[T, W]) HasLoop() bool {
+	for i := range mg.nodes {
+		if len(mg.matrix[i][i]) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParallelBFS returns the layers of a breadth first search from source, each one
+// expanded by up to workers goroutines.
+func (mg *matrixGraph[T, W]) ParallelBFS(source *Node[T, W], workers int) ([]list.List[*Node[T, W]], error) {
+	if !mg.Contains(source) {
+		return nil, nodeNotFoundError(source.data, "matrixGraph.ParallelBFS")
+	}
+
+	if workers < 1 {
+		return nil, invalidParameterError("workers", workers, "matrixGraph.ParallelBFS")
+	}
+
+	return parallelBFS[T, W](mg, source, workers), nil
+}
+
+func (mg *matrixGraph[T, W]) HasCycle() bool {
+	return hasCycle[T, W](mg)
+}
+
+func (mg *matrixGraph[T, W]) AreAdjacent(a, b *Node[T, W]) (bool, error) {
+	if !mg.Contains(a) {
+		return false, nodeNotFoundError(a.data, "matrixGraph.AreAdjacent")
+	}
+
+	if !mg.Contains(b) {
+		return false, nodeNotFoundError(b.data, "matrixGraph.AreAdjacent")
+	}
+
+	if len(mg.matrix[mg.indexes[a]][mg.indexes[b]]) > 0 {
+		return true, nil
+	}
+
+	return false, edgeNotFoundError(a.data, b.data, "matrixGraph.AreAdjacent")
+}
+
+func (mg *matrixGraph[T, W]) EdgeWeight(a, b *Node[T, W]) (W, error) {
+	if !mg.Contains(a) {
+		return internal.Zero, nodeNotFoundError(a.data, "matrixGraph.EdgeWeight")
+	}
+
+	if !mg.Contains(b) {
+		return internal.Zero, nodeNotFoundError(b.data, "matrixGraph.EdgeWeight")
+	}
+
+	edges := mg.matrix[mg.indexes[a]][mg.indexes[b]]
+	if len(edges) == 0 {
+		return internal.Zero, edgeNotFoundError(a.data, b.data, "matrixGraph.EdgeWeight")
+	}
+
+	// parallel edges weigh as much as the cheapest of them.
+	res := edges[0].weight
+	for _, e := range edges[1:] {
+		if e.weight < res {
+			res = e.weight
+		}
+	}
+
+	return res, nil
+}
+
+func (mg *matrixGraph[T, W]) FindEdge(a, b *Node[T, W], label string) (*Edge[T, W], error) {
+	if !mg.Contains(a) {
+		return nil, nodeNotFoundError(a.data, "matrixGraph.FindEdge")
+	}
+
+	if !mg.Contains(b) {
+		return nil, nodeNotFoundError(b.data, "matrixGraph.FindEdge")
+	}
+
+	for _, e := range mg.matrix[mg.indexes[a]][mg.indexes[b]] {
+		if e.label == label {
+			return e.view(a), nil
+		}
+	}
+
+	return nil, labeledEdgeNotFoundError(a.data, b.data, label, "matrixGraph.FindEdge")
+}
+
+func (mg *matrixGraph[T, W]) EdgesBetween(a, b *Node[T, W]) (list.List[*Edge[T, W]], error) {
+	if !mg.Contains(a) {
+		return nil, nodeNotFoundError(a.data, "matrixGraph.EdgesBetween")
+	}
+
+	if !mg.Contains(b) {
+		return nil, nodeNotFoundError(b.data, "matrixGraph.EdgesBetween")
+	}
+
+	res := list.NewArrayList[*Edge[T, W]]()
+	for _, e := range mg.matrix[mg.indexes[a]][mg.indexes[b]] {
+		res.Add(e.view(a))
+	}
+
+	return res, nil
+}
+
+func (mg *matrixGraph[T, W]) InDegreeOfNode(a *Node[T, W]) (int64, error) {
+	if !mg.Contains(a) {
+		return internal.Zero, nodeNotFoundError(a.data, "matrixGraph.InDegreeOfNode")
+	}
+
+	var res int64
+
+	k := mg.indexes[a]
+	for i := range mg.nodes {
+		res += int64(len(mg.matrix[i][k]))
+	}
+
+	return res, nil
+}
+
+func (mg *matrixGraph[T, W]) OutDegreeOfNode(a *Node[T, W]) (int64, error) {
+	if !mg.Contains(a) {
+		return internal.Zero, nodeNotFoundError(a.data, "matrixGraph.OutDegreeOfNode")
+	}
+
+	return a.edges.Size(), nil
+}
+
+func (mg *matrixGraph[T, W]) Reverse() {
+	reverse[T, W](mg)
+
+	for i := range mg.matrix {
+		for j := i + 1; j < len(mg.matrix); j++ {
+			mg.matrix[i][j], mg.matrix[j][i] = mg.matrix[j][i], mg.matrix[i][j]
+		}
+	}
+}
+
+func (mg *matrixGraph[T, W]) Clone() Graph[T, W] {
+	res := NewWeightedMatrixGraph[T, W]().(*matrixGraph[T, W])
+
+	for _, n := range mg.nodes {
+		c := NewWeightedNode[T, W](n.data)
+		c.attributes = n.attributes.copy()
+		res.AddNode(c)
+	}
+
+	for i, n := range mg.nodes {
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+
+			j := mg.indexes[e.next]
+			res.link(i, j, e.copyTo(res.nodes[j]))
+		}
+	}
+
+	return res
+}
+
+func (mg *matrixGraph[T, W]) HasRoute(source, target *Node[T, W]) (bool, error) {
+	if !mg.Contains(source) {
+		return false, nodeNotFoundError(source.data, "matrixGraph.HasRoute")
+	}
+
+	if !mg.Contains(target) {
+		return false, nodeNotFoundError(target.data, "matrixGraph.HasRoute")
+	}
+
+	return hasRoute(source, target), nil
+}
+
+func (mg *matrixGraph[T, W]) TopologicalSort() (list.List[*Node[T, W]], error) {
+	return topologicalOrder[T, W](mg, "matrixGraph.TopologicalSort")
+}
+
+func (mg *matrixGraph[T, W]) TopologicalLayers() ([]list.List[*Node[T, W]], error) {
+	return topologicalLayers[T, W](mg, "matrixGraph.TopologicalLayers")
+}
+
+func (mg *matrixGraph[T, W]) GetConnectedComponents() []list.List[*Node[T, W]] {
+	return koasraju[T, W](mg)
+}
+
+func (mg *matrixGraph[T, W]) StronglyConnectedComponents() []list.List[*Node[T, W]] {
+	return tarjan[T, W](mg)
+}
+
+func (mg *matrixGraph[T, W]) WeaklyConnectedComponents() []list.List[*Node[T, W]] {
+	return weaklyConnectedComponents[T, W](mg)
+}
+
+func (mg *matrixGraph[T, W]) Bridges() (list.List[*Edge[T, W]], error) {
+	if !isUndirected[T, W](mg) {
+		return nil, undirectedGraphRequiredError("matrixGraph.Bridges")
+	}
+
+	return newBiconnectivity[T, W](mg).bridges, nil
+}
+
+func (mg *matrixGraph[T, W]) ArticulationPoints() (list.List[*Node[T, W]], error) {
+	if !isUndirected[T, W](mg) {
+		return nil, undirectedGraphRequiredError("matrixGraph.ArticulationPoints")
+	}
+
+	return newBiconnectivity[T, W](mg).articulationPoints, nil
+}
+
+func (mg *matrixGraph[T, W]) BiconnectedComponents() ([]list.List[*Node[T, W]], error) {
+	if !isUndirected[T, W](mg) {
+		return nil, undirectedGraphRequiredError("matrixGraph.BiconnectedComponents")
+	}
+
+	return newBiconnectivity[T, W](mg).components, nil
+}
+
+func (mg *matrixGraph[T, W]) ShortestPath(source, target *Node[T, W], properties ...Property) (list.List[*Node[T, W]], error) {
+	if !mg.Contains(source) {
+		return nil, nodeNotFoundError(source.data, "matrixGraph.ShortestPath")
+	}
+
+	if !mg.Contains(target) {
+		return nil, nodeNotFoundError(target.data, "matrixGraph.ShortestPath")
+	}
+
+	return shortestPath[T, W](mg, source, target, properties...)
+}
+
+func (mg *matrixGraph[T, W]) ShortestPathTree(source *Node[T, W], properties ...Property) (*ShortestPathTree[T, W], error) {
+	if !mg.Contains(source) {
+		return nil, nodeNotFoundError(source.data, "matrixGraph.ShortestPathTree")
+	}
+
+	return shortestPathTree[T, W](mg, source, properties...)
+}
+
+func (mg *matrixGraph[T, W]) FloydWarshall() (*AllPairsShortestPaths[T, W], error) {
+	return floydWarshall[T, W](mg)
+}
+
+func (mg *matrixGraph[T, W]) Johnson() (*AllPairsShortestPaths[T, W], error) {
+	return johnson[T, W](mg)
+}
+
+func (mg *matrixGraph[T, W]) AStar(source, target *Node[T, W], heuristic Heuristic[T, W]) (*PathResult[T, W], error) {
+	if err := checkEndpoints[T, W](mg, source, target, "matrixGraph.AStar"); err != nil {
+		return nil, err
+	}
+
+	return aStar[T, W](mg, source, target, heuristic)
+}
+
+func (mg *matrixGraph[T, W]) BidirectionalDijkstra(source, target *Node[T, W]) (*PathResult[T, W], error) {
+	if err := checkEndpoints[T, W](mg, source, target, "matrixGraph.BidirectionalDijkstra"); err != nil {
+		return nil, err
+	}
+
+	return bidirectionalDijkstra[T, W](mg, source, target)
+}
+
+func (mg *matrixGraph[T, W]) BidirectionalBFS(source, target *Node[T, W]) (*PathResult[T, W], error) {
+	if err := checkEndpoints[T, W](mg, source, target, "matrixGraph.BidirectionalBFS"); err != nil {
+		return nil, err
+	}
+
+	return bidirectionalBFS[T, W](mg, source, target)
+}
+
+func (mg *matrixGraph[T, W]) Subgraph(nodes list.List[*Node[T, W]]) (Graph[T, W], error) {
+	return subgraph[T, W](mg, nodes, "matrixGraph.Subgraph")
+}
+
+func (mg *matrixGraph[T, W]) EdgeSubgraph(edges list.List[*Edge[T, W]]) (Graph[T, W], error) {
+	return edgeSubgraph[T, W](mg, edges, "matrixGraph.EdgeSubgraph")
+}
+
+func (mg *matrixGraph[T, W]) Neighborhood(n *Node[T, W], k int) (Graph[T, W], error) {
+	if !mg.Contains(n) {
+		return nil, nodeNotFoundError(n.data, "matrixGraph.Neighborhood")
+	}
+
+	if k < 0 {
+		return nil, negativeHopCountError(k, "matrixGraph.Neighborhood")
+	}
+
+	return neighborhood[T, W](mg, n, k), nil
+}
+
+func (mg *matrixGraph[T, W]) Contract(nodes list.List[*Node[T, W]], data T) (Graph[T, W], error) {
+	return contraction[T, W](mg, nodes, data, "matrixGraph.Contract")
+}
+
+func (mg *matrixGraph[T, W]) Complement() Graph[T, W] {
+	return complement[T, W](mg)
+}
+
+func (mg *matrixGraph[T, W]) Transpose() Graph[T, W] {
+	return transpose[T, W](mg)
+}
+
+func (mg *matrixGraph[T, W]) KruskalMST() (Graph[T, W], W, error) {
+	return kruskal[T, W](mg, NewWeightedMatrixGraph[T, W])
+}
+
+func (mg *matrixGraph[T, W]) PrimMST() (Graph[T, W], W, error) {
+	return prim[T, W](mg, NewWeightedMatrixGraph[T, W])
+}
@@ -0,0 +1,299 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMatrixGraphAddNode(t *testing.T) {
+	g := NewMatrixGraph[int]()
+
+	for i := 0; i < 10; i++ {
+		n := NewNode[int](i)
+		g.AddNode(n)
+		g.AddNode(n)
+		assert.True(t, g.Contains(n))
+	}
+
+	mg := g.(*matrixGraph[int, int64])
+	assert.Equal(t, 10, len(mg.nodes))
+	assert.Equal(t, 10, len(mg.matrix))
+
+	for _, row := range mg.matrix {
+		assert.Equal(t, 10, len(row))
+	}
+
+	assert.False(t, g.Contains(NewNode[int](1)))
+}
+
+func TestMatrixGraphCreateEdges(t *testing.T) {
+	g := NewMatrixGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+
+	g.AddNode(a)
+	g.AddNode(b)
+
+	require.NoError(t, g.CreateDiEdge(a, b))
+	require.NoError(t, g.CreateWeightedBiEdge(a, b, 5))
+
+	w, err := g.EdgeWeight(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), w)
+
+	w, err = g.EdgeWeight(b, a)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), w)
+
+	assert.Equal(t, int64(2), a.edges.Size())
+	assert.Equal(t, int64(2), first(g.EdgesBetween(a, b)).Size())
+
+	internal.AssertErrorEquals(t, errors.New("node 3 not found in the graph"), g.CreateDiEdge(a, c))
+	internal.AssertErrorEquals(t, errors.New("node 3 not found in the graph"), g.CreateBiEdge(c, a))
+	internal.AssertErrorEquals(t, errors.New("node 3 not found in the graph"), g.CreateWeightedDiEdge(c, a, 1))
+}
+
+func TestMatrixGraphDeleteNode(t *testing.T) {
+	g := NewMatrixGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+
+	createEdge(g, false, b, a, c)
+	createEdge(g, false, c, a, b)
+	createWeightedEdge(g, false, a, c, 7)
+
+	require.NoError(t, g.DeleteNode(a))
+	assert.False(t, g.Contains(a))
+
+	_, err := b.findEdge(a)
+	internal.AssertErrorEquals(t, errors.New("edge 2 to 1 not found in the graph"), err)
+
+	_, err = c.findEdge(a)
+	internal.AssertErrorEquals(t, errors.New("edge 3 to 1 not found in the graph"), err)
+
+	ok, err := g.AreAdjacent(b, c)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = g.AreAdjacent(c, b)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	assert.Equal(t, map[int][]int{2: {3}, 3: {2}}, simplifyGraph(g))
+
+	internal.AssertErrorEquals(t, errors.New("node 1 not found in the graph"), g.DeleteNode(a))
+}
+
+func TestMatrixGraphDeleteEdge(t *testing.T) {
+	g := NewMatrixGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+
+	createEdge(g, false, a, b)
+
+	require.NoError(t, g.DeleteEdge(a, b))
+
+	ok, err := g.AreAdjacent(a, b)
+	assert.False(t, ok)
+	internal.AssertErrorEquals(t, errors.New("edge 1 to 2 not found in the graph"), err)
+
+	_, err = a.findEdge(b)
+	internal.AssertErrorEquals(t, errors.New("edge 1 to 2 not found in the graph"), err)
+
+	internal.AssertErrorEquals(t, errors.New("edge 1 to 2 not found in the graph"), g.DeleteEdge(a, b))
+	internal.AssertErrorEquals(t, errors.New("node 3 not found in the graph"), g.DeleteEdge(a, NewNode[int](3)))
+}
+
+func TestMatrixGraphEdgeWeightFailure(t *testing.T) {
+	g := NewMatrixGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+
+	g.AddNode(a)
+
+	_, err := g.EdgeWeight(a, b)
+	internal.AssertErrorEquals(t, errors.New("node 2 not found in the graph"), err)
+
+	g.AddNode(b)
+
+	_, err = g.EdgeWeight(a, b)
+	internal.AssertErrorEquals(t, errors.New("edge 1 to 2 not found in the graph"), err)
+}
+
+func TestMatrixGraphReverse(t *testing.T) {
+	g, _ := graphTwentyFive()
+	mg := toMatrixGraph(g)
+
+	exp, _ := graphTwentyFiveReverse()
+
+	mg.Reverse()
+	assert.True(t, internal.AreMapsSame[int, []int](simplifyGraph(exp), simplifyGraph(mg), intSliceComparator{}))
+
+	ei := mg.Edges()
+	for ei.HasNext() {
+		e, _ := ei.Next()
+
+		ok, err := mg.AreAdjacent(e.From(), e.To())
+		require.NoError(t, err)
+		assert.True(t, ok)
+	}
+}
+
+func TestMatrixGraphClone(t *testing.T) {
+	for _, g := range getAllGraphs() {
+		mg := toMatrixGraph(g)
+		cl := mg.Clone()
+
+		assert.Equal(t, simplifyGraph(mg), simplifyGraph(cl))
+
+		ni := cl.Nodes()
+		for ni.HasNext() {
+			n, _ := ni.Next()
+			assert.False(t, mg.Contains(n))
+		}
+	}
+}
+
+// parallelBiEdgesGraph is a triangle whose first two nodes are joined twice
+// without labels, with different weights.
+func parallelBiEdgesGraph() (Graph[int, int64], propertySet) {
+	one := NewNode[int](1)
+	two := NewNode[int](2)
+	three := NewNode[int](3)
+
+	g := NewListGraph[int]()
+	createWeightedEdge(g, true, one, two, 5)
+	createWeightedEdge(g, true, one, two, 2)
+	createWeightedEdge(g, true, two, three, 4)
+	createWeightedEdge(g, true, three, one, 1)
+	return g, newPropertySet(unDirected, weighted, cyclic, connected)
+}
+
+func TestMatrixGraphParityWithListGraph(t *testing.T) {
+	toData := func(it iterator.Iterator[*Node[int, int64]]) []int {
+		res := make([]int, 0)
+		for it.HasNext() {
+			n, _ := it.Next()
+			res = append(res, n.data)
+		}
+		return res
+	}
+
+	g, ps := parallelBiEdgesGraph()
+	graphSets := append(getAllGraphSets(), graphSet[int]{g: g, ps: ps})
+
+	for i, gs := range graphSets {
+		lg := gs.g
+		mg := toMatrixGraph(lg)
+
+		assert.Equal(t, simplifyGraph(lg), simplifyGraph(mg), "graph %d", i)
+		assert.Equal(t, edgeList(lg), edgeList(mg), "graph %d", i)
+
+		_, lw, lerr := lg.KruskalMST()
+		_, mw, merr := mg.KruskalMST()
+		assert.Equal(t, lw, mw, "graph %d", i)
+		internal.AssertErrorEquals(t, lerr, merr)
+
+		assert.Equal(t, lg.HasLoop(), mg.HasLoop(), "graph %d", i)
+		assert.Equal(t, lg.HasCycle(), mg.HasCycle(), "graph %d", i)
+		assert.Equal(t, normalizeCycles(ElementaryCycles(lg)), normalizeCycles(ElementaryCycles(mg)), "graph %d", i)
+
+		_, lerr = FindCycle(lg)
+		_, merr = FindCycle(mg)
+		internal.AssertErrorEquals(t, lerr, merr)
+
+		_, lerr = EulerianPath(lg)
+		_, merr = EulerianPath(mg)
+		internal.AssertErrorEquals(t, lerr, merr)
+
+		assert.Equal(t, toData(lg.BFSIterator()), toData(mg.BFSIterator()), "graph %d", i)
+		assert.Equal(t, toData(lg.DFSIterator()), toData(mg.DFSIterator()), "graph %d", i)
+
+		lt, lerr := lg.TopologicalSort()
+		mt, merr := mg.TopologicalSort()
+		assert.True(t, isListEqual(lt, mt), "graph %d", i)
+		internal.AssertErrorEquals(t, lerr, merr)
+
+		lfw, lerr := lg.FloydWarshall()
+		mfw, merr := mg.FloydWarshall()
+		internal.AssertErrorEquals(t, lerr, merr)
+
+		data := toData(lg.Nodes())
+
+		for _, x := range data {
+			la, ma := getNodeWithVal(lg, x), getNodeWithVal(mg, x)
+
+			assert.Equal(t, first(lg.InDegreeOfNode(la)), first(mg.InDegreeOfNode(ma)), "graph %d node %d", i, x)
+			assert.Equal(t, first(lg.OutDegreeOfNode(la)), first(mg.OutDegreeOfNode(ma)), "graph %d node %d", i, x)
+
+			for _, y := range data {
+				lb, mb := getNodeWithVal(lg, y), getNodeWithVal(mg, y)
+
+				lok, lerr := lg.AreAdjacent(la, lb)
+				mok, merr := mg.AreAdjacent(ma, mb)
+				assert.Equal(t, lok, mok)
+				internal.AssertErrorEquals(t, lerr, merr)
+
+				lw, lerr := lg.EdgeWeight(la, lb)
+				mw, merr := mg.EdgeWeight(ma, mb)
+				assert.Equal(t, lw, mw)
+				internal.AssertErrorEquals(t, lerr, merr)
+
+				le, lerr := lg.EdgesBetween(la, lb)
+				me, merr := mg.EdgesBetween(ma, mb)
+				internal.AssertErrorEquals(t, lerr, merr)
+				if lerr == nil {
+					assert.Equal(t, le.Size(), me.Size(), "graph %d edges %d to %d", i, x, y)
+				}
+
+				assert.Equal(t, first(lg.HasRoute(la, lb)), first(mg.HasRoute(ma, mb)), "graph %d route %d to %d", i, x, y)
+
+				lp, lerr := lg.ShortestPath(la, lb, gs.ps.properties()...)
+				mp, merr := mg.ShortestPath(ma, mb, gs.ps.properties()...)
+				assert.True(t, isListEqual(lp, mp), "graph %d path %d to %d", i, x, y)
+				internal.AssertErrorEquals(t, lerr, merr)
+
+				lr, lerr := lg.BidirectionalBFS(la, lb)
+				mr, merr := mg.BidirectionalBFS(ma, mb)
+				internal.AssertErrorEquals(t, lerr, merr)
+				if lerr == nil {
+					assert.Equal(t, lr.Weight(), mr.Weight())
+				}
+
+				if lfw != nil {
+					ld, lerr := lfw.Distance(la, lb)
+					md, merr := mfw.Distance(ma, mb)
+					assert.Equal(t, ld, md, "graph %d distance %d to %d", i, x, y)
+					internal.AssertErrorEquals(t, lerr, merr)
+				}
+			}
+		}
+
+		assert.True(t, areComponentsEqual(lg.GetConnectedComponents(), mg.GetConnectedComponents()), "graph %d", i)
+	}
+}
+
+func TestMatrixGraphMinimumSpanningTree(t *testing.T) {
+	g, _ := graphSeventeen()
+	mg := toMatrixGraph(g)
+
+	tree, weight, err := mg.KruskalMST()
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), weight)
+	assert.IsType(t, &matrixGraph[int, int64]{}, tree)
+
+	tree, weight, err = mg.PrimMST()
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), weight)
+	assert.IsType(t, &matrixGraph[int, int64]{}, tree)
+}
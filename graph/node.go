@@ -2,21 +2,59 @@ package graph
 
 import (
 	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	"github.com/nsnikhil/go-datastructures/list"
 	"github.com/nsnikhil/go-datastructures/queue"
 	"github.com/nsnikhil/go-datastructures/set"
 	"github.com/nsnikhil/go-datastructures/stack"
 )
 
-type Node[T any] struct {
-	data  T
-	edges set.Set[*edge[T]]
+type Node[T any, W Weight] struct {
+	data       T
+	edges      set.Set[*edge[T, W]]
+	attributes Attributes
 
 	// SHORTEST PATH
 	//costToReach int64
-	//predecessor *Node[T]
+	//predecessor *Node[T, W]
 }
 
-func (n *Node[T]) addEdge(e *edge[T]) {
+func (n *Node[T, W]) Data() T {
+	return n.data
+}
+
+func (n *Node[T, W]) SetData(data T) {
+	n.data = data
+}
+
+func (n *Node[T, W]) Attributes() Attributes {
+	return n.attributes
+}
+
+func (n *Node[T, W]) Neighbors() list.List[*Node[T, W]] {
+	res := list.NewArrayList[*Node[T, W]]()
+
+	it := n.edges.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res.Add(e.next)
+	}
+
+	return res
+}
+
+func (n *Node[T, W]) Edges() list.List[*Edge[T, W]] {
+	res := list.NewArrayList[*Edge[T, W]]()
+
+	it := n.edges.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res.Add(e.view(n))
+	}
+
+	return res
+}
+
+func (n *Node[T, W]) addEdge(e *edge[T, W]) {
 	if n.edges.Contains(e) {
 		return
 	}
@@ -24,7 +62,7 @@ func (n *Node[T]) addEdge(e *edge[T]) {
 	n.edges.Add(e)
 }
 
-func (n *Node[T]) removeEdge(e *edge[T]) error {
+func (n *Node[T, W]) removeEdge(e *edge[T, W]) error {
 	if !n.edges.Contains(e) {
 		return edgeNotFoundError(n.data, e.next.data, "Node.removeEdge")
 	}
@@ -36,26 +74,67 @@ func (n *Node[T]) removeEdge(e *edge[T]) error {
 	return nil
 }
 
-func (n *Node[T]) clearEdges() {
+func (n *Node[T, W]) clearEdges() {
 	n.edges.Clear()
 }
 
-func (n *Node[T]) findEdge(o *Node[T]) (*edge[T], error) {
-	it := n.edges.Iterator()
+// findEdge returns the cheapest of the parallel edges to o.
+func (n *Node[T, W]) findEdge(o *Node[T, W]) (*edge[T, W], error) {
+	var res *edge[T, W]
+
+	for _, e := range n.findEdges(o) {
+		if res == nil || e.weight < res.weight {
+			res = e
+		}
+	}
+
+	if res == nil {
+		return nil, edgeNotFoundError(n.data, o.data, "Node.findEdge")
+	}
+
+	return res, nil
+}
 
+func (n *Node[T, W]) findEdges(o *Node[T, W]) []*edge[T, W] {
+	res := make([]*edge[T, W], 0)
+
+	it := n.edges.Iterator()
 	for it.HasNext() {
 		e, _ := it.Next()
 		if e.next == o {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
+
+func edgesBetween[T any, W Weight](a, b *Node[T, W]) list.List[*Edge[T, W]] {
+	res := list.NewArrayList[*Edge[T, W]]()
+
+	for _, e := range a.findEdges(b) {
+		res.Add(e.view(a))
+	}
+
+	return res
+}
+
+func (n *Node[T, W]) findLabeledEdge(o *Node[T, W], label string) (*edge[T, W], error) {
+	it := n.edges.Iterator()
+
+	for it.HasNext() {
+		e, _ := it.Next()
+		if e.next == o && e.label == label {
 			return e, nil
 		}
 	}
 
-	return nil, edgeNotFoundError(n.data, o.data, "Node.findEdge")
+	return nil, labeledEdgeNotFoundError(n.data, o.data, label, "Node.findLabeledEdge")
 }
 
-func (n *Node[T]) copy() *Node[T] {
-	copyEdges := func(edges set.Set[*edge[T]]) set.Set[*edge[T]] {
-		res := set.NewHashSet[*edge[T]]()
+func (n *Node[T, W]) copy() *Node[T, W] {
+	copyEdges := func(edges set.Set[*edge[T, W]]) set.Set[*edge[T, W]] {
+		res := set.NewHashSet[*edge[T, W]]()
 
 		it := edges.Iterator()
 		for it.HasNext() {
@@ -66,30 +145,31 @@ func (n *Node[T]) copy() *Node[T] {
 		return res
 	}
 
-	return &Node[T]{
-		data:  n.data,
-		edges: copyEdges(n.edges),
+	return &Node[T, W]{
+		data:       n.data,
+		edges:      copyEdges(n.edges),
+		attributes: n.attributes.copy(),
 	}
 }
 
-func (n *Node[T]) bfsIterator() iterator.Iterator[*Node[T]] {
-	return newNodeBfsIterator[T](n)
+func (n *Node[T, W]) bfsIterator() iterator.Iterator[*Node[T, W]] {
+	return newNodeBfsIterator[T, W](n)
 }
 
-func (n *Node[T]) dfsIterator() iterator.Iterator[*Node[T]] {
-	return newNodeDfsIterator[T](n)
+func (n *Node[T, W]) dfsIterator() iterator.Iterator[*Node[T, W]] {
+	return newNodeDfsIterator[T, W](n)
 }
 
-type nodeBfsIterator[T any] struct {
-	qu queue.Queue[*Node[T]]
-	vs set.Set[*Node[T]]
+type nodeBfsIterator[T any, W Weight] struct {
+	qu queue.Queue[*Node[T, W]]
+	vs set.Set[*Node[T, W]]
 }
 
-func (nbi *nodeBfsIterator[T]) HasNext() bool {
+func (nbi *nodeBfsIterator[T, W]) HasNext() bool {
 	return !nbi.qu.Empty()
 }
 
-func (nbi *nodeBfsIterator[T]) Next() (*Node[T], error) {
+func (nbi *nodeBfsIterator[T, W]) Next() (*Node[T, W], error) {
 	v, err := nbi.qu.Remove()
 	if err != nil {
 		return nil, emptyIteratorError("nodeBfsIterator.Next")
@@ -108,43 +188,43 @@ func (nbi *nodeBfsIterator[T]) Next() (*Node[T], error) {
 }
 
 //TODO: REFACTOR REMOVE ADDING ALL EDGES AT ONCE
-func newNodeBfsIterator[T any](n *Node[T]) iterator.Iterator[*Node[T]] {
-	qu := queue.NewLinkedQueue[*Node[T]]()
+func newNodeBfsIterator[T any, W Weight](n *Node[T, W]) iterator.Iterator[*Node[T, W]] {
+	qu := queue.NewLinkedQueue[*Node[T, W]]()
 	qu.Add(n)
 
-	vs := set.NewHashSet[*Node[T]]()
+	vs := set.NewHashSet[*Node[T, W]]()
 	vs.Add(n)
 
-	return &nodeBfsIterator[T]{
+	return &nodeBfsIterator[T, W]{
 		qu: qu,
 		vs: vs,
 	}
 }
 
 //TODO: RENAME
-func newNodeBfsIteratorWithVisited[T any](n *Node[T], vs set.Set[*Node[T]]) iterator.Iterator[*Node[T]] {
-	qu := queue.NewLinkedQueue[*Node[T]]()
+func newNodeBfsIteratorWithVisited[T any, W Weight](n *Node[T, W], vs set.Set[*Node[T, W]]) iterator.Iterator[*Node[T, W]] {
+	qu := queue.NewLinkedQueue[*Node[T, W]]()
 	qu.Add(n)
 
 	vs.Add(n)
 
-	return &nodeBfsIterator[T]{
+	return &nodeBfsIterator[T, W]{
 		qu: qu,
 		vs: vs,
 	}
 }
 
-type nodeDfsIterator[T any] struct {
-	st *stack.Stack[*Node[T]]
-	vs set.Set[*Node[T]]
+type nodeDfsIterator[T any, W Weight] struct {
+	st *stack.Stack[*Node[T, W]]
+	vs set.Set[*Node[T, W]]
 }
 
-func (nbi *nodeDfsIterator[T]) HasNext() bool {
+func (nbi *nodeDfsIterator[T, W]) HasNext() bool {
 	return !nbi.st.Empty()
 }
 
 //TODO: REFACTOR REMOVE ADDING ALL EDGES AT ONCE
-func (nbi *nodeDfsIterator[T]) Next() (*Node[T], error) {
+func (nbi *nodeDfsIterator[T, W]) Next() (*Node[T, W], error) {
 	v, err := nbi.st.Pop()
 	if err != nil {
 		return nil, emptyIteratorError("nodeDfsIterator.Next")
@@ -162,35 +242,40 @@ func (nbi *nodeDfsIterator[T]) Next() (*Node[T], error) {
 	return v, nil
 }
 
-func newNodeDfsIterator[T any](n *Node[T]) iterator.Iterator[*Node[T]] {
-	st := stack.NewStack[*Node[T]]()
+func newNodeDfsIterator[T any, W Weight](n *Node[T, W]) iterator.Iterator[*Node[T, W]] {
+	st := stack.NewStack[*Node[T, W]]()
 	st.Push(n)
 
-	vs := set.NewHashSet[*Node[T]]()
+	vs := set.NewHashSet[*Node[T, W]]()
 	vs.Add(n)
 
-	return &nodeDfsIterator[T]{
+	return &nodeDfsIterator[T, W]{
 		st: st,
 		vs: vs,
 	}
 }
 
 //TODO: RENAME
-func newNodeDfsIteratorWithVisited[T any](n *Node[T], vs set.Set[*Node[T]]) iterator.Iterator[*Node[T]] {
-	st := stack.NewStack[*Node[T]]()
+func newNodeDfsIteratorWithVisited[T any, W Weight](n *Node[T, W], vs set.Set[*Node[T, W]]) iterator.Iterator[*Node[T, W]] {
+	st := stack.NewStack[*Node[T, W]]()
 	st.Push(n)
 
 	vs.Add(n)
 
-	return &nodeDfsIterator[T]{
+	return &nodeDfsIterator[T, W]{
 		st: st,
 		vs: vs,
 	}
 }
 
-func NewNode[T any](data T) *Node[T] {
-	return &Node[T]{
-		data:  data,
-		edges: set.NewHashSet[*edge[T]](),
+func NewNode[T any](data T) *Node[T, int64] {
+	return NewWeightedNode[T, int64](data)
+}
+
+func NewWeightedNode[T any, W Weight](data T) *Node[T, W] {
+	return &Node[T, W]{
+		data:       data,
+		edges:      set.NewHashSet[*edge[T, W]](),
+		attributes: make(Attributes),
 	}
 }
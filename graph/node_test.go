@@ -12,7 +12,7 @@ import (
 
 func TestCreateNewNode(t *testing.T) {
 	for i := 0; i < math.MaxInt8; i++ {
-		assert.Equal(t, &Node[int]{data: i, edges: set.NewHashSet[*edge[int]]()}, NewNode[int](i))
+		assert.Equal(t, &Node[int, int64]{data: i, edges: set.NewHashSet[*edge[int, int64]](), attributes: Attributes{}}, NewNode[int](i))
 	}
 }
 
@@ -81,12 +81,12 @@ func TestNodeClearEdges(t *testing.T) {
 
 func TestNodeFindEdge(t *testing.T) {
 	testCases := map[string]struct {
-		res           func() (*edge[int], error)
-		expectedRes   *edge[int]
+		res           func() (*edge[int, int64], error)
+		expectedRes   *edge[int, int64]
 		expectedError error
 	}{
 		"should find edge successfully": {
-			res: func() (*edge[int], error) {
+			res: func() (*edge[int, int64], error) {
 				a := NewNode[int](1)
 				b := NewNode[int](2)
 
@@ -99,7 +99,7 @@ func TestNodeFindEdge(t *testing.T) {
 			expectedRes: newDiEdge[int](NewNode[int](2)),
 		},
 		"should return error when edge is not connected to the node": {
-			res: func() (*edge[int], error) {
+			res: func() (*edge[int, int64], error) {
 				a := NewNode[int](1)
 				b := NewNode[int](2)
 
@@ -120,17 +120,17 @@ func TestNodeFindEdge(t *testing.T) {
 
 func TestNodeCopy(t *testing.T) {
 	testCases := map[string]struct {
-		res         func() *Node[int]
-		expectedRes func() *Node[int]
+		res         func() *Node[int, int64]
+		expectedRes func() *Node[int, int64]
 	}{
 		"should copy node with no edges": {
-			res: func() *Node[int] {
+			res: func() *Node[int, int64] {
 				return NewNode[int](1).copy()
 			},
-			expectedRes: func() *Node[int] { return NewNode[int](1) },
+			expectedRes: func() *Node[int, int64] { return NewNode[int](1) },
 		},
 		"should copy node with edges": {
-			res: func() *Node[int] {
+			res: func() *Node[int, int64] {
 				a := NewNode[int](1)
 				b := NewNode[int](2)
 				c := NewNode[int](3)
@@ -149,7 +149,7 @@ func TestNodeCopy(t *testing.T) {
 
 				return a.copy()
 			},
-			expectedRes: func() *Node[int] {
+			expectedRes: func() *Node[int, int64] {
 				a := NewNode[int](1)
 				b := NewNode[int](2)
 				c := NewNode[int](3)
@@ -419,3 +419,43 @@ func TestNodeDfsIteratorNext(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeData(t *testing.T) {
+	n := NewNode[string]("a")
+	assert.Equal(t, "a", n.Data())
+
+	n.SetData("b")
+	assert.Equal(t, "b", n.Data())
+}
+
+func TestNodeNeighborsAndEdges(t *testing.T) {
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+
+	a.addEdge(newWeightedDiEdge[int](b, 4))
+	a.addEdge(newWeightedDiEdge[int](c, 5))
+
+	neighbors := make([]int, 0)
+
+	nit := a.Neighbors().Iterator()
+	for nit.HasNext() {
+		n, _ := nit.Next()
+		neighbors = append(neighbors, n.Data())
+	}
+
+	assert.ElementsMatch(t, []int{2, 3}, neighbors)
+
+	weights := make(map[int]int64)
+
+	eit := a.Edges().Iterator()
+	for eit.HasNext() {
+		e, _ := eit.Next()
+		assert.Equal(t, a, e.From())
+		weights[e.To().Data()] = e.Weight()
+	}
+
+	assert.Equal(t, map[int]int64{2: 4, 3: 5}, weights)
+	assert.True(t, c.Neighbors().IsEmpty())
+	assert.True(t, c.Edges().IsEmpty())
+}
@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"github.com/nsnikhil/go-datastructures/list"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelBFS is a level synchronous breadth first search, the frontier of every
+// level is split in equal parts expanded by up to workers goroutines and the next
+// level starts once all of them are done. A node belongs to the goroutine that
+// claims it first, so the order of the nodes inside a layer is not fixed.
+func parallelBFS[T any, W Weight](g Graph[T, W], source *Node[T, W], workers int) []list.List[*Node[T, W]] {
+	_, indexes := indexNodes(g)
+
+	claimed := make([]int32, len(indexes))
+	claimed[indexes[source]] = 1
+
+	res := make([]list.List[*Node[T, W]], 0)
+
+	for frontier := []*Node[T, W]{source}; len(frontier) > 0; {
+		res = append(res, list.NewArrayList[*Node[T, W]](frontier...))
+
+		size := (len(frontier) + workers - 1) / workers
+		parts := make([][]*Node[T, W], (len(frontier)+size-1)/size)
+
+		var wg sync.WaitGroup
+
+		for i := range parts {
+			wg.Add(1)
+
+			go func(i int, chunk []*Node[T, W]) {
+				defer wg.Done()
+
+				for _, n := range chunk {
+					it := n.edges.Iterator()
+					for it.HasNext() {
+						e, _ := it.Next()
+						if atomic.CompareAndSwapInt32(&claimed[indexes[e.next]], 0, 1) {
+							parts[i] = append(parts[i], e.next)
+						}
+					}
+				}
+			}(i, frontier[i*size:minInt((i+1)*size, len(frontier))])
+		}
+
+		wg.Wait()
+
+		frontier = make([]*Node[T, W], 0)
+		for _, p := range parts {
+			frontier = append(frontier, p...)
+		}
+	}
+
+	return res
+}
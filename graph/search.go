@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/nsnikhil/go-datastructures/queue"
+)
+
+// Heuristic estimates the cost from a node to the target of an AStar search,
+// it should never overestimate for the returned path to be the shortest.
+type Heuristic[T any, W Weight] func(data T) W
+
+type step[T any, W Weight] struct {
+	next   *Node[T, W]
+	weight W
+}
+
+type stepFunc[T any, W Weight] func(n *Node[T, W]) []step[T, W]
+
+func outgoingSteps[T any, W Weight](n *Node[T, W]) []step[T, W] {
+	res := make([]step[T, W], 0, n.edges.Size())
+
+	it := n.edges.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res = append(res, step[T, W]{next: e.next, weight: e.weight})
+	}
+
+	return res
+}
+
+func incomingSteps[T any, W Weight](g Graph[T, W]) stepFunc[T, W] {
+	incoming := make(map[*Node[T, W]][]step[T, W])
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+			incoming[e.next] = append(incoming[e.next], step[T, W]{next: n, weight: e.weight})
+		}
+	}
+
+	return func(n *Node[T, W]) []step[T, W] {
+		return incoming[n]
+	}
+}
+
+type searchFrontier[T any, W Weight] struct {
+	costs        map[*Node[T, W]]W
+	predecessors map[*Node[T, W]]*Node[T, W]
+	steps        stepFunc[T, W]
+	q            *queue.PriorityQueue[*nodeWrapper[T, W]]
+}
+
+func newSearchFrontier[T any, W Weight](start *Node[T, W], steps stepFunc[T, W]) *searchFrontier[T, W] {
+	return &searchFrontier[T, W]{
+		costs:        map[*Node[T, W]]W{start: 0},
+		predecessors: make(map[*Node[T, W]]*Node[T, W]),
+		steps:        steps,
+		q:            queue.NewPriorityQueue[*nodeWrapper[T, W]](false, &nodeComparator[T, W]{}),
+	}
+}
+
+func (sf *searchFrontier[T, W]) relax(from *Node[T, W], s step[T, W]) bool {
+	cost := sf.costs[from] + s.weight
+
+	if c, ok := sf.costs[s.next]; ok && c <= cost {
+		return false
+	}
+
+	sf.costs[s.next] = cost
+	sf.predecessors[s.next] = from
+	return true
+}
+
+// joinPaths walks back from meet to the source of the forward search and then
+// forward from meet to the target using the predecessors of the backward search.
+func joinPaths[T any, W Weight](meet *Node[T, W], forward, backward map[*Node[T, W]]*Node[T, W], cost W) *PathResult[T, W] {
+	res := list.NewLinkedList[*Node[T, W]]()
+
+	for curr := meet; curr != nil; curr = forward[curr] {
+		res.AddFirst(curr)
+	}
+
+	for curr := backward[meet]; curr != nil; curr = backward[curr] {
+		res.AddLast(curr)
+	}
+
+	return &PathResult[T, W]{nodes: res, weight: cost}
+}
+
+// nonNegativeWeights fails on the first negative weight of g, the searches below
+// settle a node once it is taken off the queue and a negative edge met later could
+// still lead to it for less.
+func nonNegativeWeights[T any, W Weight](g Graph[T, W], operation erx.Operation) error {
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+			if e.weight < 0 {
+				return invalidParameterError("weight", e.weight, operation)
+			}
+		}
+	}
+
+	return nil
+}
+
+func aStar[T any, W Weight](g Graph[T, W], source, target *Node[T, W], heuristic Heuristic[T, W]) (*PathResult[T, W], error) {
+	if err := nonNegativeWeights(g, "aStar"); err != nil {
+		return nil, err
+	}
+
+	sf := newSearchFrontier[T, W](source, outgoingSteps[T, W])
+	sf.q.Add(&nodeWrapper[T, W]{curr: source, costToReach: heuristic(source.data)})
+
+	for !sf.q.Empty() {
+		w, _ := sf.q.Remove()
+
+		// skip entries queued before a cheaper route to the node was found.
+		if w.costToReach != sf.costs[w.curr]+heuristic(w.curr.data) {
+			continue
+		}
+
+		if w.curr == target {
+			return joinPaths(target, sf.predecessors, nil, sf.costs[target]), nil
+		}
+
+		for _, s := range sf.steps(w.curr) {
+			if sf.relax(w.curr, s) {
+				sf.q.Add(&nodeWrapper[T, W]{curr: s.next, costToReach: sf.costs[s.next] + heuristic(s.next.data)})
+			}
+		}
+	}
+
+	return nil, pathNotFoundError(source.data, target.data, "aStar")
+}
+
+func bidirectionalDijkstra[T any, W Weight](g Graph[T, W], source, target *Node[T, W]) (*PathResult[T, W], error) {
+	if err := nonNegativeWeights(g, "bidirectionalDijkstra"); err != nil {
+		return nil, err
+	}
+
+	forward := newSearchFrontier[T, W](source, outgoingSteps[T, W])
+	backward := newSearchFrontier[T, W](target, incomingSteps(g))
+
+	forward.q.Add(&nodeWrapper[T, W]{curr: source})
+	backward.q.Add(&nodeWrapper[T, W]{curr: target})
+
+	var meet *Node[T, W]
+	var best W
+
+	if source == target {
+		meet = source
+	}
+
+	for !forward.q.Empty() && !backward.q.Empty() {
+		f, _ := forward.q.Peek()
+		b, _ := backward.q.Peek()
+
+		if meet != nil && f.costToReach+b.costToReach >= best {
+			break
+		}
+
+		curr, other := forward, backward
+		if b.costToReach < f.costToReach {
+			curr, other = backward, forward
+		}
+
+		w, _ := curr.q.Remove()
+		if w.costToReach != curr.costs[w.curr] {
+			continue
+		}
+
+		for _, s := range curr.steps(w.curr) {
+			if !curr.relax(w.curr, s) {
+				continue
+			}
+
+			curr.q.Add(&nodeWrapper[T, W]{curr: s.next, costToReach: curr.costs[s.next]})
+
+			if c, ok := other.costs[s.next]; ok && (meet == nil || curr.costs[s.next]+c < best) {
+				meet, best = s.next, curr.costs[s.next]+c
+			}
+		}
+	}
+
+	if meet == nil {
+		return nil, pathNotFoundError(source.data, target.data, "bidirectionalDijkstra")
+	}
+
+	return joinPaths(meet, forward.predecessors, backward.predecessors, best), nil
+}
+
+// bidirectionalBFS measures the cost in number of edges, a whole level is expanded
+// before checking for a meeting node so that the shortest meeting is picked.
+func bidirectionalBFS[T any, W Weight](g Graph[T, W], source, target *Node[T, W]) (*PathResult[T, W], error) {
+	if source == target {
+		return joinPaths(source, nil, nil, 0), nil
+	}
+
+	forward := newSearchFrontier[T, W](source, outgoingSteps[T, W])
+	backward := newSearchFrontier[T, W](target, incomingSteps(g))
+
+	forwardLevel := []*Node[T, W]{source}
+	backwardLevel := []*Node[T, W]{target}
+
+	for len(forwardLevel) > 0 && len(backwardLevel) > 0 {
+		curr, other, level := forward, backward, &forwardLevel
+		if len(backwardLevel) < len(forwardLevel) {
+			curr, other, level = backward, forward, &backwardLevel
+		}
+
+		var meet *Node[T, W]
+		var best W
+
+		next := make([]*Node[T, W], 0)
+		for _, n := range *level {
+			for _, s := range curr.steps(n) {
+				if _, ok := curr.costs[s.next]; ok {
+					continue
+				}
+
+				curr.costs[s.next] = curr.costs[n] + 1
+				curr.predecessors[s.next] = n
+				next = append(next, s.next)
+
+				if c, ok := other.costs[s.next]; ok && (meet == nil || curr.costs[s.next]+c < best) {
+					meet, best = s.next, curr.costs[s.next]+c
+				}
+			}
+		}
+
+		if meet != nil {
+			return joinPaths(meet, forward.predecessors, backward.predecessors, best), nil
+		}
+
+		*level = next
+	}
+
+	return nil, pathNotFoundError(source.data, target.data, "bidirectionalBFS")
+}
@@ -0,0 +1,182 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListGraphAStar(t *testing.T) {
+	const size = 6
+
+	manhattan := func(target int) Heuristic[int, int64] {
+		abs := func(a int) int64 {
+			if a < 0 {
+				return int64(-a)
+			}
+			return int64(a)
+		}
+
+		return func(data int) int64 {
+			return abs(data/size-target/size) + abs(data%size-target%size)
+		}
+	}
+
+	testCases := map[string]struct {
+		blocked   []int
+		source    int
+		target    int
+		heuristic func(target int) Heuristic[int, int64]
+	}{
+		"should find the shortest path on an open grid": {
+			source:    0,
+			target:    35,
+			heuristic: manhattan,
+		},
+		"should find the shortest path around a wall": {
+			blocked:   []int{3, 9, 15, 21, 27},
+			source:    0,
+			target:    5,
+			heuristic: manhattan,
+		},
+		"should behave like dijkstra with a zero heuristic": {
+			blocked:   []int{1, 7, 13, 19, 25, 14, 15, 16},
+			source:    0,
+			target:    23,
+			heuristic: func(int) Heuristic[int, int64] { return func(int) int64 { return 0 } },
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			g := graphGrid(size, size, testCase.blocked...)
+
+			source := getNodeWithVal(g, testCase.source)
+			target := getNodeWithVal(g, testCase.target)
+
+			res, err := g.AStar(source, target, testCase.heuristic(testCase.target))
+			require.NoError(t, err)
+
+			spt, err := g.ShortestPathTree(source, NonNegativeWeights)
+			require.NoError(t, err)
+
+			assert.Equal(t, first(spt.Distance(target)), res.Weight())
+			assert.Equal(t, res.Weight(), first(pathWeight(g, res.Nodes())))
+			assert.Equal(t, source, first(res.Nodes().Get(0)))
+			assert.Equal(t, target, first(res.Nodes().Get(res.Nodes().Size()-1)))
+		})
+	}
+}
+
+func TestListGraphAStarFailure(t *testing.T) {
+	g := graphGrid(3, 3, 2, 4, 6)
+
+	zero := func(int) int64 { return 0 }
+
+	_, err := g.AStar(getNodeWithVal(g, 0), getNodeWithVal(g, 8), zero)
+	internal.AssertErrorEquals(t, errors.New("path 0 to 8 not found in the graph"), err)
+
+	_, err = g.AStar(getNodeWithVal(g, 0), NewNode[int](9), zero)
+	internal.AssertErrorEquals(t, errors.New("node 9 not found in the graph"), err)
+
+	createWeightedEdge(g, false, getNodeWithVal(g, 7), getNodeWithVal(g, 8), -3)
+
+	_, err = g.AStar(getNodeWithVal(g, 0), getNodeWithVal(g, 1), zero)
+	internal.AssertErrorEquals(t, errors.New("invalid weight -3"), err)
+}
+
+func TestListGraphBidirectionalDijkstra(t *testing.T) {
+	graphs := []Graph[int, int64]{graphGrid(5, 5, 6, 7, 8, 16, 17, 18), graphGrid(4, 4, 1, 5, 9, 13)}
+
+	for _, gs := range getAllGraphSets() {
+		if !gs.ps.hasProperty(negativeWeights) {
+			graphs = append(graphs, gs.g)
+		}
+	}
+
+	for i, g := range graphs {
+		ni := g.Nodes()
+		for ni.HasNext() {
+			source, _ := ni.Next()
+
+			spt, err := g.ShortestPathTree(source, NonNegativeWeights)
+			require.NoError(t, err)
+
+			ti := g.Nodes()
+			for ti.HasNext() {
+				target, _ := ti.Next()
+
+				res, err := g.BidirectionalDijkstra(source, target)
+				if !spt.HasPathTo(target) {
+					internal.AssertErrorEquals(t, fmt.Errorf("path %d to %d not found in the graph", source.data, target.data), err)
+					continue
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, first(spt.Distance(target)), res.Weight(), "graph %d path %d to %d", i, source.data, target.data)
+				assert.Equal(t, res.Weight(), first(pathWeight(g, res.Nodes())))
+				assert.Equal(t, source, first(res.Nodes().Get(0)))
+				assert.Equal(t, target, first(res.Nodes().Get(res.Nodes().Size()-1)))
+			}
+		}
+	}
+}
+
+func TestListGraphBidirectionalDijkstraFailure(t *testing.T) {
+	g := NewListGraph[int]()
+
+	a, b, c := NewNode[int](1), NewNode[int](2), NewNode[int](3)
+
+	createWeightedEdge(g, false, a, b, 4)
+	createWeightedEdge(g, false, a, c, 5)
+	createWeightedEdge(g, false, c, b, -2)
+
+	_, err := g.BidirectionalDijkstra(a, b)
+	internal.AssertErrorEquals(t, errors.New("invalid weight -2"), err)
+
+	mg := toMatrixGraph(g)
+
+	_, err = mg.BidirectionalDijkstra(getNodeWithVal(mg, 1), getNodeWithVal(mg, 2))
+	internal.AssertErrorEquals(t, errors.New("invalid weight -2"), err)
+
+	_, err = mg.AStar(getNodeWithVal(mg, 1), getNodeWithVal(mg, 2), func(int) int64 { return 0 })
+	internal.AssertErrorEquals(t, errors.New("invalid weight -2"), err)
+}
+
+func TestListGraphBidirectionalBFS(t *testing.T) {
+	graphs := append(getAllGraphs(), graphGrid(5, 5, 6, 7, 8, 16, 17, 18), graphGrid(4, 4, 1, 5, 9, 13))
+
+	for i, g := range graphs {
+		ni := g.Nodes()
+		for ni.HasNext() {
+			source, _ := ni.Next()
+
+			spt, err := g.ShortestPathTree(source, UnWeighted)
+			require.NoError(t, err)
+
+			ti := g.Nodes()
+			for ti.HasNext() {
+				target, _ := ti.Next()
+
+				res, err := g.BidirectionalBFS(source, target)
+				if !spt.HasPathTo(target) {
+					internal.AssertErrorEquals(t, fmt.Errorf("path %d to %d not found in the graph", source.data, target.data), err)
+					continue
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, first(spt.Distance(target)), res.Weight(), "graph %d path %d to %d", i, source.data, target.data)
+				assert.Equal(t, res.Weight()+1, res.Nodes().Size())
+
+				_, err = pathWeight(g, res.Nodes())
+				assert.NoError(t, err)
+			}
+		}
+	}
+
+	_, err := NewListGraph[int]().BidirectionalBFS(NewNode[int](1), NewNode[int](2))
+	internal.AssertErrorEquals(t, errors.New("node 1 not found in the graph"), err)
+}
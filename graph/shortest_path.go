@@ -0,0 +1,256 @@
+package graph
+
+import (
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+)
+
+type PathResult[T any, W Weight] struct {
+	nodes  list.List[*Node[T, W]]
+	weight W
+}
+
+func (pr *PathResult[T, W]) Nodes() list.List[*Node[T, W]] {
+	return pr.nodes
+}
+
+func (pr *PathResult[T, W]) Weight() W {
+	return pr.weight
+}
+
+type ShortestPathTree[T any, W Weight] struct {
+	source       *Node[T, W]
+	distances    gmap.Map[*Node[T, W], W]
+	predecessors gmap.Map[*Node[T, W], *Node[T, W]]
+}
+
+func newShortestPathTree[T any, W Weight](source *Node[T, W], distances gmap.Map[*Node[T, W], W], predecessors gmap.Map[*Node[T, W], *Node[T, W]]) *ShortestPathTree[T, W] {
+	return &ShortestPathTree[T, W]{
+		source:       source,
+		distances:    distances,
+		predecessors: predecessors,
+	}
+}
+
+func (spt *ShortestPathTree[T, W]) Source() *Node[T, W] {
+	return spt.source
+}
+
+func (spt *ShortestPathTree[T, W]) HasPathTo(target *Node[T, W]) bool {
+	return spt.distances.ContainsKey(target)
+}
+
+func (spt *ShortestPathTree[T, W]) Distance(target *Node[T, W]) (W, error) {
+	if !spt.HasPathTo(target) {
+		return internal.Zero, pathNotFoundError(spt.source.data, target.data, "ShortestPathTree.Distance")
+	}
+
+	return first(spt.distances.Get(target)), nil
+}
+
+// Predecessor returns nil for the source of the tree.
+func (spt *ShortestPathTree[T, W]) Predecessor(target *Node[T, W]) (*Node[T, W], error) {
+	if !spt.HasPathTo(target) {
+		return nil, pathNotFoundError(spt.source.data, target.data, "ShortestPathTree.Predecessor")
+	}
+
+	if target == spt.source {
+		return nil, nil
+	}
+
+	return first(spt.predecessors.Get(target)), nil
+}
+
+func (spt *ShortestPathTree[T, W]) PathTo(target *Node[T, W]) (*PathResult[T, W], error) {
+	if !spt.HasPathTo(target) {
+		return nil, pathNotFoundError(spt.source.data, target.data, "ShortestPathTree.PathTo")
+	}
+
+	res := list.NewLinkedList[*Node[T, W]]()
+
+	curr := target
+	for {
+		res.AddFirst(curr)
+
+		if curr == spt.source {
+			break
+		}
+
+		par, err := spt.predecessors.Get(curr)
+		if err != nil || par == nil {
+			return nil, pathNotFoundError(spt.source.data, target.data, "ShortestPathTree.PathTo")
+		}
+
+		curr = par
+	}
+
+	return &PathResult[T, W]{nodes: res, weight: first(spt.distances.Get(target))}, nil
+}
+
+type AllPairsShortestPaths[T any, W Weight] struct {
+	trees map[*Node[T, W]]*ShortestPathTree[T, W]
+}
+
+func (ap *AllPairsShortestPaths[T, W]) Tree(source *Node[T, W]) (*ShortestPathTree[T, W], error) {
+	spt, ok := ap.trees[source]
+	if !ok {
+		return nil, nodeNotFoundError(source.data, "AllPairsShortestPaths.Tree")
+	}
+
+	return spt, nil
+}
+
+func (ap *AllPairsShortestPaths[T, W]) Distance(source, target *Node[T, W]) (W, error) {
+	spt, err := ap.Tree(source)
+	if err != nil {
+		return internal.Zero, err
+	}
+
+	return spt.Distance(target)
+}
+
+func (ap *AllPairsShortestPaths[T, W]) Path(source, target *Node[T, W]) (*PathResult[T, W], error) {
+	spt, err := ap.Tree(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return spt.PathTo(target)
+}
+
+func floydWarshall[T any, W Weight](g Graph[T, W]) (*AllPairsShortestPaths[T, W], error) {
+	nodes := make([]*Node[T, W], 0)
+	indexes := make(map[*Node[T, W]]int)
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		indexes[n] = len(nodes)
+		nodes = append(nodes, n)
+	}
+
+	sz := len(nodes)
+
+	// reached tells apart the pairs without a path, their distance is left at zero.
+	dist := make([][]W, sz)
+	reached := make([][]bool, sz)
+	pred := make([][]int, sz)
+
+	for i := 0; i < sz; i++ {
+		dist[i] = make([]W, sz)
+		reached[i] = make([]bool, sz)
+		pred[i] = make([]int, sz)
+
+		for j := 0; j < sz; j++ {
+			pred[i][j] = internal.InvalidIndex
+		}
+
+		reached[i][i] = true
+	}
+
+	for i, n := range nodes {
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+			j := indexes[e.next]
+
+			if !reached[i][j] || e.weight < dist[i][j] {
+				dist[i][j] = e.weight
+				reached[i][j] = true
+				pred[i][j] = i
+			}
+		}
+	}
+
+	for k := 0; k < sz; k++ {
+		for i := 0; i < sz; i++ {
+			if !reached[i][k] {
+				continue
+			}
+
+			for j := 0; j < sz; j++ {
+				if !reached[k][j] {
+					continue
+				}
+
+				if !reached[i][j] || dist[i][k]+dist[k][j] < dist[i][j] {
+					dist[i][j] = dist[i][k] + dist[k][j]
+					reached[i][j] = true
+					pred[i][j] = pred[k][j]
+				}
+			}
+		}
+	}
+
+	res := &AllPairsShortestPaths[T, W]{trees: make(map[*Node[T, W]]*ShortestPathTree[T, W])}
+
+	for i, source := range nodes {
+		if dist[i][i] < 0 {
+			return nil, negativeCycleError("floydWarshall")
+		}
+
+		cm := gmap.NewHashMap[*Node[T, W], W]()
+		pm := gmap.NewHashMap[*Node[T, W], *Node[T, W]]()
+
+		for j, n := range nodes {
+			if reached[i][j] {
+				cm.Put(n, dist[i][j])
+			}
+
+			if pred[i][j] == internal.InvalidIndex {
+				pm.Put(n, nil)
+			} else {
+				pm.Put(n, nodes[pred[i][j]])
+			}
+		}
+
+		res.trees[source] = newShortestPathTree(source, cm, pm)
+	}
+
+	return res, nil
+}
+
+// johnson reweights every edge with the bellmen ford potentials so that dijkstra
+// can run from every node, the distances are shifted back before returning.
+func johnson[T any, W Weight](g Graph[T, W]) (*AllPairsShortestPaths[T, W], error) {
+	h := gmap.NewHashMap[*Node[T, W], W]()
+	pm := gmap.NewHashMap[*Node[T, W], *Node[T, W]]()
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		h.Put(n, internal.Zero)
+		pm.Put(n, nil)
+	}
+
+	if relaxEdges(g, h, pm) != nil {
+		return nil, negativeCycleError("johnson")
+	}
+
+	reweighted := func(from *Node[T, W], e *edge[T, W]) W {
+		return e.weight + first(h.Get(from)) - first(h.Get(e.next))
+	}
+
+	res := &AllPairsShortestPaths[T, W]{trees: make(map[*Node[T, W]]*ShortestPathTree[T, W])}
+
+	it = g.Nodes()
+	for it.HasNext() {
+		source, _ := it.Next()
+
+		spt := dijkstra(source, g, reweighted)
+
+		ni := g.Nodes()
+		for ni.HasNext() {
+			n, _ := ni.Next()
+
+			if d, err := spt.distances.Get(n); err == nil {
+				spt.distances.Put(n, d-first(h.Get(source))+first(h.Get(n)))
+			}
+		}
+
+		res.trees[source] = spt
+	}
+
+	return res, nil
+}
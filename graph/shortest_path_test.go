@@ -0,0 +1,156 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListGraphShortestPathTree(t *testing.T) {
+	g, _ := graphTwenty()
+
+	zero := getNodeWithVal(g, 0)
+	three := getNodeWithVal(g, 3)
+
+	spt, err := g.ShortestPathTree(zero, NonNegativeWeights)
+	require.NoError(t, err)
+	assert.Equal(t, zero, spt.Source())
+
+	for data, distance := range map[int]int64{0: 0, 1: 2, 2: 6, 3: 9} {
+		d, err := spt.Distance(getNodeWithVal(g, data))
+		require.NoError(t, err)
+		assert.Equal(t, distance, d)
+	}
+
+	for data, predecessor := range map[int]int{1: 0, 2: 1, 3: 2} {
+		p, err := spt.Predecessor(getNodeWithVal(g, data))
+		require.NoError(t, err)
+		assert.Equal(t, predecessor, p.Data())
+	}
+
+	p, err := spt.Predecessor(zero)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	pr, err := spt.PathTo(three)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), pr.Weight())
+	assert.True(t, isListEqual(toNodeList(0, 1, 2, 3), pr.Nodes()))
+
+	spt, err = g.ShortestPathTree(three)
+	require.NoError(t, err)
+	assert.False(t, spt.HasPathTo(zero))
+
+	_, err = spt.Distance(zero)
+	internal.AssertErrorEquals(t, errors.New("path 3 to 0 not found in the graph"), err)
+
+	_, err = spt.PathTo(zero)
+	internal.AssertErrorEquals(t, errors.New("path 3 to 0 not found in the graph"), err)
+
+	_, err = g.ShortestPathTree(NewNode[int](9))
+	internal.AssertErrorEquals(t, errors.New("node 9 not found in the graph"), err)
+}
+
+func TestListGraphUnWeightedShortestPathTree(t *testing.T) {
+	g, _ := graphOne()
+
+	spt, err := g.ShortestPathTree(getNodeWithVal(g, 4), UnWeighted)
+	require.NoError(t, err)
+
+	pr, err := spt.PathTo(getNodeWithVal(g, 5))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pr.Weight())
+	assert.True(t, isListEqual(toNodeList(4, 6, 5), pr.Nodes()))
+}
+
+func TestListGraphAllPairsShortestPaths(t *testing.T) {
+	g := NewListGraph[int]()
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+	c := NewNode[int](3)
+	d := NewNode[int](4)
+
+	createWeightedEdge(g, false, a, b, 4)
+	createWeightedEdge(g, false, a, c, 2)
+	createWeightedEdge(g, false, c, b, -3)
+	createWeightedEdge(g, false, b, d, 1)
+
+	for name, compute := range map[string]func() (*AllPairsShortestPaths[int, int64], error){
+		"floyd warshall": g.FloydWarshall,
+		"johnson":        g.Johnson,
+	} {
+		t.Run(name, func(t *testing.T) {
+			ap, err := compute()
+			require.NoError(t, err)
+
+			for data, distance := range map[int]int64{1: 0, 2: -1, 3: 2, 4: 0} {
+				res, err := ap.Distance(a, getNodeWithVal(g, data))
+				require.NoError(t, err)
+				assert.Equal(t, distance, res)
+			}
+
+			pr, err := ap.Path(a, d)
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), pr.Weight())
+			assert.True(t, isListEqual(toNodeList(1, 3, 2, 4), pr.Nodes()))
+
+			_, err = ap.Path(d, a)
+			internal.AssertErrorEquals(t, errors.New("path 4 to 1 not found in the graph"), err)
+
+			_, err = ap.Tree(NewNode[int](9))
+			internal.AssertErrorEquals(t, errors.New("node 9 not found in the graph"), err)
+		})
+	}
+}
+
+func TestListGraphAllPairsShortestPathsMatchBellmenFord(t *testing.T) {
+	for _, gs := range getAllGraphSets() {
+		g := gs.g
+
+		if gs.ps.hasProperty(negativeCycles) {
+			_, err := g.FloydWarshall()
+			internal.AssertErrorEquals(t, errors.New("graph has negative weight cycle"), err)
+
+			_, err = g.Johnson()
+			internal.AssertErrorEquals(t, errors.New("graph has negative weight cycle"), err)
+			continue
+		}
+
+		fw, err := g.FloydWarshall()
+		require.NoError(t, err)
+
+		jn, err := g.Johnson()
+		require.NoError(t, err)
+
+		ni := g.Nodes()
+		for ni.HasNext() {
+			source, _ := ni.Next()
+
+			bf, err := g.ShortestPathTree(source)
+			require.NoError(t, err)
+
+			ti := g.Nodes()
+			for ti.HasNext() {
+				target, _ := ti.Next()
+
+				expected, expectedErr := bf.Distance(target)
+
+				for _, ap := range []*AllPairsShortestPaths[int, int64]{fw, jn} {
+					res, err := ap.Distance(source, target)
+					assert.Equal(t, expected, res)
+					internal.AssertErrorEquals(t, expectedErr, err)
+
+					pr, err := ap.Path(source, target)
+					if err != nil {
+						continue
+					}
+
+					assert.Equal(t, expected, first(pathWeight(g, pr.Nodes())))
+				}
+			}
+		}
+	}
+}
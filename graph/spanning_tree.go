@@ -0,0 +1,164 @@
+package graph
+
+import (
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/disjointSets"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/nsnikhil/go-datastructures/queue"
+)
+
+// indexedEdge is an edge of g between the nodes at indexes from and to.
+type indexedEdge[T any, W Weight] struct {
+	from, to int
+	weight   W
+	source   *edge[T, W]
+}
+
+type indexedEdgeComparator[T any, W Weight] struct{}
+
+func (iec indexedEdgeComparator[T, W]) Compare(one *indexedEdge[T, W], two *indexedEdge[T, W]) int {
+	if one.weight < two.weight {
+		return -1
+	}
+
+	if one.weight > two.weight {
+		return 1
+	}
+
+	return 0
+}
+
+// spanningForest copies the nodes of g into a new graph, the edges of the
+// spanning tree are added later in both directions with their labels and
+// attributes.
+type spanningForest[T any, W Weight] struct {
+	nodes   []*Node[T, W]
+	indexes map[*Node[T, W]]int
+	copies  []*Node[T, W]
+	res     Graph[T, W]
+	weight  W
+}
+
+func newSpanningForest[T any, W Weight](g Graph[T, W], newGraph func() Graph[T, W], operation erx.Operation) (*spanningForest[T, W], error) {
+	sf := &spanningForest[T, W]{
+		nodes:   make([]*Node[T, W], 0),
+		indexes: make(map[*Node[T, W]]int),
+		copies:  make([]*Node[T, W], 0),
+		res:     newGraph(),
+	}
+
+	if !isUndirected[T, W](g) {
+		return nil, undirectedGraphRequiredError(operation)
+	}
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+
+		sf.indexes[n] = len(sf.nodes)
+		sf.nodes = append(sf.nodes, n)
+
+		sf.copies = append(sf.copies, copyNode(sf.res, n))
+	}
+
+	return sf, nil
+}
+
+// join copies e and the edge going back from to to from, which has the same label
+// and weight since g is undirected.
+func (sf *spanningForest[T, W]) join(e *indexedEdge[T, W]) {
+	copyEdge(sf.res, sf.copies[e.from], sf.copies[e.to], e.source)
+
+	back := e.source
+	for _, b := range sf.nodes[e.to].findEdges(sf.nodes[e.from]) {
+		if b.label == e.source.label && b.weight == e.source.weight {
+			back = b
+			break
+		}
+	}
+
+	copyEdge(sf.res, sf.copies[e.to], sf.copies[e.from], back)
+	sf.weight += e.weight
+}
+
+func kruskal[T any, W Weight](g Graph[T, W], newGraph func() Graph[T, W]) (Graph[T, W], W, error) {
+	sf, err := newSpanningForest(g, newGraph, "kruskal")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	edges := list.NewArrayList[*indexedEdge[T, W]]()
+	ds := disjointSets.NewDisjointSets[int]()
+
+	for i, n := range sf.nodes {
+		ds.MakeSet(i)
+
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+
+			// every undirected edge is stored in both directions, keep one of them.
+			if j := sf.indexes[e.next]; i < j {
+				edges.Add(&indexedEdge[T, W]{from: i, to: j, weight: e.weight, source: e})
+			}
+		}
+	}
+
+	edges.Sort(indexedEdgeComparator[T, W]{})
+
+	it := edges.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+
+		if same, _ := ds.AreInSameSet(e.from, e.to); same {
+			continue
+		}
+
+		_ = ds.Union(e.from, e.to)
+		sf.join(e)
+	}
+
+	return sf.res, sf.weight, nil
+}
+
+func prim[T any, W Weight](g Graph[T, W], newGraph func() Graph[T, W]) (Graph[T, W], W, error) {
+	sf, err := newSpanningForest(g, newGraph, "prim")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	visited := make([]bool, len(sf.nodes))
+	q := queue.NewPriorityQueue[*indexedEdge[T, W]](false, indexedEdgeComparator[T, W]{})
+
+	visit := func(i int) {
+		visited[i] = true
+
+		it := sf.nodes[i].edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			if j := sf.indexes[e.next]; !visited[j] {
+				q.Add(&indexedEdge[T, W]{from: i, to: j, weight: e.weight, source: e})
+			}
+		}
+	}
+
+	for i := range sf.nodes {
+		if visited[i] {
+			continue
+		}
+
+		visit(i)
+
+		for !q.Empty() {
+			e, _ := q.Remove()
+			if visited[e.to] {
+				continue
+			}
+
+			sf.join(e)
+			visit(e.to)
+		}
+	}
+
+	return sf.res, sf.weight, nil
+}
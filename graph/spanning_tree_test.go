@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListGraphMinimumSpanningTree(t *testing.T) {
+	testCases := map[string]struct {
+		graph          func() (Graph[int, int64], propertySet)
+		expectedWeight int64
+		expectedEdges  int
+	}{
+		"should return spanning tree for triangle": {
+			graph:          graphFifteen,
+			expectedWeight: 5,
+			expectedEdges:  2,
+		},
+		"should return spanning tree for square with diagonal": {
+			graph:          graphSixteen,
+			expectedWeight: 9,
+			expectedEdges:  3,
+		},
+		"should return spanning tree for six nodes": {
+			graph:          graphSeventeen,
+			expectedWeight: 12,
+			expectedEdges:  5,
+		},
+		"should return spanning tree for two joined triangles": {
+			graph:          graphEighteen,
+			expectedWeight: 18,
+			expectedEdges:  5,
+		},
+		"should return spanning forest for disconnected graph": {
+			graph:          graphTwentyFour,
+			expectedWeight: 0,
+			expectedEdges:  len(simplifyGraph(first(graphTwentyFour()))) - len(first(graphTwentyFour()).GetConnectedComponents()),
+		},
+	}
+
+	for name, testCase := range testCases {
+		for algorithm, mst := range map[string]func(Graph[int, int64]) (Graph[int, int64], int64, error){
+			"kruskal": Graph[int, int64].KruskalMST,
+			"prim":    Graph[int, int64].PrimMST,
+		} {
+			t.Run(fmt.Sprintf("%s %s", algorithm, name), func(t *testing.T) {
+				g, _ := testCase.graph()
+
+				tree, weight, err := mst(g)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedWeight, weight)
+
+				edges := 0
+				var total int64
+
+				it := tree.Edges()
+				for it.HasNext() {
+					e, _ := it.Next()
+
+					w, err := g.EdgeWeight(getNodeWithVal(g, e.From().Data()), getNodeWithVal(g, e.To().Data()))
+					require.NoError(t, err)
+					assert.Equal(t, w, e.Weight())
+
+					edges++
+					total += e.Weight()
+				}
+
+				assert.Equal(t, testCase.expectedEdges*2, edges)
+				assert.Equal(t, testCase.expectedWeight*2, total)
+				assert.Equal(t, len(simplifyGraph(g)), len(simplifyGraph(tree)))
+				assert.False(t, tree.Contains(getNodeWithVal(g, first(g.Nodes().Next()).Data())))
+				assert.Equal(t, len(g.GetConnectedComponents()), len(tree.GetConnectedComponents()))
+			})
+		}
+	}
+}
+
+func TestListGraphMinimumSpanningTreeOnGrid(t *testing.T) {
+	g := graphGrid(6, 6, 8, 14, 20)
+
+	_, kw, err := g.KruskalMST()
+	require.NoError(t, err)
+
+	_, pw, err := g.PrimMST()
+	require.NoError(t, err)
+
+	assert.Equal(t, kw, pw)
+}
+
+func TestListGraphMinimumSpanningTreeFailure(t *testing.T) {
+	g, _ := graphTwenty()
+
+	_, _, err := g.KruskalMST()
+	internal.AssertErrorEquals(t, errors.New("graph is not undirected"), err)
+
+	_, _, err = g.PrimMST()
+	internal.AssertErrorEquals(t, errors.New("graph is not undirected"), err)
+}
+
+func TestListGraphMinimumSpanningTreeKeepsLabelsAndAttributes(t *testing.T) {
+	for algorithm, mst := range map[string]func(Graph[int, int64]) (Graph[int, int64], int64, error){
+		"kruskal": Graph[int, int64].KruskalMST,
+		"prim":    Graph[int, int64].PrimMST,
+	} {
+		t.Run(algorithm, func(t *testing.T) {
+			g := NewListGraph[int]()
+
+			a, b := NewNode[int](1), NewNode[int](2)
+			g.AddNode(a)
+			g.AddNode(b)
+
+			require.NoError(t, g.CreateLabeledBiEdge(a, b, "road", 5))
+			require.NoError(t, g.CreateLabeledBiEdge(a, b, "rail", 3))
+
+			a.Attributes()["name"] = "depot"
+
+			e, err := g.FindEdge(b, a, "rail")
+			require.NoError(t, err)
+			e.Attributes()["lanes"] = 2
+
+			tree, weight, err := mst(g)
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), weight)
+			assert.Equal(t, map[string]int64{"1>2:rail": 3, "2>1:rail": 3}, labeledWeights(tree))
+
+			ta, tb := getNodeWithVal(tree, 1), getNodeWithVal(tree, 2)
+			assert.Equal(t, Attributes{"name": "depot"}, ta.Attributes())
+
+			te, err := tree.FindEdge(tb, ta, "rail")
+			require.NoError(t, err)
+			assert.Equal(t, Attributes{"lanes": 2}, te.Attributes())
+
+			te.Attributes()["lanes"] = 4
+			assert.Equal(t, Attributes{"lanes": 2}, e.Attributes())
+		})
+	}
+}
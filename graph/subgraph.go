@@ -0,0 +1,453 @@
+package graph
+
+import (
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/nsnikhil/go-datastructures/queue"
+)
+
+// newGraphLike returns an empty graph of the same kind as g.
+func newGraphLike[T any, W Weight](g Graph[T, W]) Graph[T, W] {
+	if _, ok := g.(*matrixGraph[T, W]); ok {
+		return NewWeightedMatrixGraph[T, W]()
+	}
+
+	return NewWeightedListGraph[T, W]()
+}
+
+// copyNode adds a copy of n without its edges to res.
+func copyNode[T any, W Weight](res Graph[T, W], n *Node[T, W]) *Node[T, W] {
+	c := NewWeightedNode[T, W](n.data)
+	c.attributes = n.attributes.copy()
+	res.AddNode(c)
+	return c
+}
+
+// edgeAttacher is implemented by the graphs of this package to add a new edge
+// as is, next to any edge already between the same nodes.
+type edgeAttacher[T any, W Weight] interface {
+	attach(from, to *Node[T, W], e *edge[T, W])
+}
+
+// copyEdge adds an edge between from and to in res with the label, weight and
+// attributes of e, both nodes must be in res.
+func copyEdge[T any, W Weight](res Graph[T, W], from, to *Node[T, W], e *edge[T, W]) {
+	if ea, ok := res.(edgeAttacher[T, W]); ok {
+		ea.attach(from, to, e.copyTo(to))
+		return
+	}
+
+	_ = res.CreateLabeledDiEdge(from, to, e.label, e.weight)
+
+	if c, err := res.FindEdge(from, to, e.label); err == nil {
+		for k, v := range e.attributes {
+			c.Attributes()[k] = v
+		}
+	}
+}
+
+func checkNodes[T any, W Weight](g Graph[T, W], nodes list.List[*Node[T, W]], operation erx.Operation) (map[*Node[T, W]]bool, error) {
+	res := make(map[*Node[T, W]]bool)
+
+	it := nodes.Iterator()
+	for it.HasNext() {
+		n, _ := it.Next()
+		if !g.Contains(n) {
+			return nil, nodeNotFoundError(n.data, operation)
+		}
+
+		res[n] = true
+	}
+
+	return res, nil
+}
+
+func checkEndpoints[T any, W Weight](g Graph[T, W], source, target *Node[T, W], operation erx.Operation) error {
+	if !g.Contains(source) {
+		return nodeNotFoundError(source.data, operation)
+	}
+
+	if !g.Contains(target) {
+		return nodeNotFoundError(target.data, operation)
+	}
+
+	return nil
+}
+
+// induced copies the kept nodes of g and every edge between two of them.
+func induced[T any, W Weight](g Graph[T, W], keep map[*Node[T, W]]bool) Graph[T, W] {
+	res := newGraphLike(g)
+	copies := make(map[*Node[T, W]]*Node[T, W])
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		if keep[n] {
+			copies[n] = copyNode(res, n)
+		}
+	}
+
+	it = g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		if !keep[n] {
+			continue
+		}
+
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+			if keep[e.next] {
+				copyEdge(res, copies[n], copies[e.next], e)
+			}
+		}
+	}
+
+	return res
+}
+
+func subgraph[T any, W Weight](g Graph[T, W], nodes list.List[*Node[T, W]], operation erx.Operation) (Graph[T, W], error) {
+	keep, err := checkNodes(g, nodes, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return induced(g, keep), nil
+}
+
+// edgeSubgraph keeps the given edges and the nodes they join, edges are matched
+// by the edge their view was taken from, see sourceEdge.
+func edgeSubgraph[T any, W Weight](g Graph[T, W], edges list.List[*Edge[T, W]], operation erx.Operation) (Graph[T, W], error) {
+	keep := make(map[*edge[T, W]]bool)
+	nodes := make(map[*Node[T, W]]bool)
+
+	it := edges.Iterator()
+	for it.HasNext() {
+		v, _ := it.Next()
+
+		if !g.Contains(v.from) {
+			return nil, nodeNotFoundError(v.from.data, operation)
+		}
+
+		if !g.Contains(v.to) {
+			return nil, nodeNotFoundError(v.to.data, operation)
+		}
+
+		e := sourceEdge(v)
+		if e == nil {
+			return nil, labeledEdgeNotFoundError(v.from.data, v.to.data, v.label, operation)
+		}
+
+		keep[e] = true
+		nodes[v.from] = true
+		nodes[v.to] = true
+	}
+
+	res := newGraphLike(g)
+	copies := make(map[*Node[T, W]]*Node[T, W])
+
+	ni := g.Nodes()
+	for ni.HasNext() {
+		n, _ := ni.Next()
+		if nodes[n] {
+			copies[n] = copyNode(res, n)
+		}
+	}
+
+	ni = g.Nodes()
+	for ni.HasNext() {
+		n, _ := ni.Next()
+
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+			if keep[e] {
+				copyEdge(res, copies[n], copies[e.next], e)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// sourceEdge returns the edge v is a view of, or the first edge between the same
+// nodes with the same label and weight when v was built by hand or its edge is
+// gone, so views of unlabeled parallel edges are told apart by their weight.
+func sourceEdge[T any, W Weight](v *Edge[T, W]) *edge[T, W] {
+	var res *edge[T, W]
+
+	for _, e := range v.from.findEdges(v.to) {
+		if e == v.source {
+			return e
+		}
+
+		if res == nil && e.label == v.label && e.weight == v.weight {
+			res = e
+		}
+	}
+
+	return res
+}
+
+// complement joins every ordered pair of distinct nodes that are not adjacent in
+// g with an unweighted edge, loops are never added.
+func complement[T any, W Weight](g Graph[T, W]) Graph[T, W] {
+	nodes, _ := indexNodes(g)
+
+	res := newGraphLike(g)
+	copies := make([]*Node[T, W], len(nodes))
+
+	for i, n := range nodes {
+		copies[i] = copyNode(res, n)
+	}
+
+	for i, a := range nodes {
+		for j, b := range nodes {
+			if i != j && len(a.findEdges(b)) == 0 {
+				_ = res.CreateDiEdge(copies[i], copies[j])
+			}
+		}
+	}
+
+	return res
+}
+
+// transpose is Reverse on a copy, g is left untouched.
+func transpose[T any, W Weight](g Graph[T, W]) Graph[T, W] {
+	nodes, indexes := indexNodes(g)
+
+	res := newGraphLike(g)
+	copies := make([]*Node[T, W], len(nodes))
+
+	for i, n := range nodes {
+		copies[i] = copyNode(res, n)
+	}
+
+	for i, n := range nodes {
+		it := n.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			copyEdge(res, copies[indexes[e.next]], copies[i], e)
+		}
+	}
+
+	return res
+}
+
+// neighborhood is the subgraph induced by the nodes at most k edges away from n.
+func neighborhood[T any, W Weight](g Graph[T, W], n *Node[T, W], k int) Graph[T, W] {
+	hops := map[*Node[T, W]]int{n: 0}
+	keep := map[*Node[T, W]]bool{n: true}
+
+	q := queue.NewLinkedQueue[*Node[T, W]]()
+	q.Add(n)
+
+	for !q.Empty() {
+		curr, _ := q.Remove()
+		if hops[curr] == k {
+			continue
+		}
+
+		it := curr.edges.Iterator()
+		for it.HasNext() {
+			e, _ := it.Next()
+			if !keep[e.next] {
+				keep[e.next] = true
+				hops[e.next] = hops[curr] + 1
+				q.Add(e.next)
+			}
+		}
+	}
+
+	return induced(g, keep)
+}
+
+// contraction replaces the given nodes with a single node holding data, edges
+// inside the set are dropped and edges leaving or entering it are moved to the
+// new node. Edges that end up parallel with the same label keep the lowest weight.
+func contraction[T any, W Weight](g Graph[T, W], nodes list.List[*Node[T, W]], data T, operation erx.Operation) (Graph[T, W], error) {
+	if nodes.Size() == 0 {
+		return nil, emptyNodeListError(operation)
+	}
+
+	merged, err := checkNodes(g, nodes, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	res := newGraphLike(g)
+	copies := make(map[*Node[T, W]]*Node[T, W])
+
+	var contracted *Node[T, W]
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+
+		if !merged[n] {
+			copies[n] = copyNode(res, n)
+			continue
+		}
+
+		if contracted == nil {
+			contracted = NewWeightedNode[T, W](data)
+			res.AddNode(contracted)
+		}
+
+		copies[n] = contracted
+	}
+
+	type ends struct {
+		from, to *Node[T, W]
+		label    string
+	}
+
+	cheapest := make(map[ends]*edge[T, W])
+	order := make([]ends, 0)
+
+	it = g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+
+			if merged[n] && merged[e.next] {
+				continue
+			}
+
+			k := ends{from: copies[n], to: copies[e.next], label: e.label}
+
+			if c, ok := cheapest[k]; !ok {
+				order = append(order, k)
+			} else if c.weight <= e.weight {
+				continue
+			}
+
+			cheapest[k] = e
+		}
+	}
+
+	for _, k := range order {
+		copyEdge(res, k.from, k.to, cheapest[k])
+	}
+
+	return res, nil
+}
+
+// Union returns a graph with the nodes and edges of both a and b, nodes are told
+// apart by their data so nodes with equal data are merged into one. Every edge of
+// a is kept, an edge of b is left out when a has the same one, that is an edge
+// between the same nodes with the same label, and the same weight as well when
+// they have no label. A labeled edge found in both graphs keeps the weight and
+// attributes it has in a. The result is of the same kind as a.
+func Union[T comparable, W Weight](a, b Graph[T, W]) Graph[T, W] {
+	a, b, unlock := readLockedPair(a, b)
+	defer unlock()
+
+	res := newGraphLike(a)
+	copies := make(map[T]*Node[T, W])
+
+	for _, g := range []Graph[T, W]{a, b} {
+		it := g.Nodes()
+		for it.HasNext() {
+			n, _ := it.Next()
+			if _, ok := copies[n.data]; !ok {
+				copies[n.data] = copyNode(res, n)
+			}
+		}
+	}
+
+	key := func(n *Node[T, W], e *edge[T, W]) edgeKey[T, W] {
+		k := edgeKey[T, W]{from: n.data, to: e.next.data, label: e.label}
+		if e.label == "" {
+			k.weight = e.weight
+		}
+
+		return k
+	}
+
+	kept := make(map[edgeKey[T, W]]int)
+
+	for i, g := range []Graph[T, W]{a, b} {
+		it := g.Nodes()
+		for it.HasNext() {
+			n, _ := it.Next()
+
+			ei := n.edges.Iterator()
+			for ei.HasNext() {
+				e, _ := ei.Next()
+
+				k := key(n, e)
+				if i == 0 {
+					kept[k]++
+				} else if kept[k] > 0 {
+					kept[k]--
+					continue
+				}
+
+				copyEdge(res, copies[n.data], copies[e.next.data], e)
+			}
+		}
+	}
+
+	return res
+}
+
+// Intersection returns a graph with the nodes whose data is in both a and b and
+// the edges between them with the same label in both graphs, weights and
+// attributes are taken from a. The result is of the same kind as a.
+func Intersection[T comparable, W Weight](a, b Graph[T, W]) Graph[T, W] {
+	a, b, unlock := readLockedPair(a, b)
+	defer unlock()
+
+	others := make(map[T]*Node[T, W])
+
+	it := b.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		others[n.data] = n
+	}
+
+	res := newGraphLike(a)
+	copies := make(map[T]*Node[T, W])
+
+	it = a.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		if _, ok := others[n.data]; !ok {
+			continue
+		}
+
+		if _, ok := copies[n.data]; !ok {
+			copies[n.data] = copyNode(res, n)
+		}
+	}
+
+	it = a.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+
+		from, ok := copies[n.data]
+		if !ok {
+			continue
+		}
+
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+
+			to, ok := copies[e.next.data]
+			if !ok {
+				continue
+			}
+
+			if _, err := others[n.data].findLabeledEdge(others[e.next.data], e.label); err == nil {
+				copyEdge(res, from, to, e)
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,219 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// subgraphFixture is the cycle 1 > 2 > 3 > 1 with a tail 3 > 4 > 5 and a labeled
+// edge parallel to 1 > 2.
+func subgraphFixture(t *testing.T, g Graph[int, int64]) []*Node[int, int64] {
+	nodes := make([]*Node[int, int64], 6)
+	for i := 1; i <= 5; i++ {
+		nodes[i] = NewNode[int](i)
+		g.AddNode(nodes[i])
+	}
+
+	createWeightedEdge(g, false, nodes[1], nodes[2], 1)
+	createWeightedEdge(g, false, nodes[2], nodes[3], 2)
+	createWeightedEdge(g, false, nodes[3], nodes[1], 3)
+	createWeightedEdge(g, false, nodes[3], nodes[4], 4)
+	createWeightedEdge(g, false, nodes[4], nodes[5], 5)
+	require.NoError(t, g.CreateLabeledDiEdge(nodes[1], nodes[2], "fast", 9))
+
+	nodes[1].Attributes()["name"] = "one"
+
+	return nodes
+}
+
+func TestSubgraphs(t *testing.T) {
+	forEachGraphKind(t, testSubgraphs)
+}
+
+func testSubgraphs(t *testing.T, newGraph func() Graph[int, int64]) {
+	g := newGraph()
+	nodes := subgraphFixture(t, g)
+	before := labeledWeights(g)
+
+	sub, err := g.Subgraph(list.NewArrayList[*Node[int, int64]](nodes[1], nodes[2], nodes[4]))
+	require.NoError(t, err)
+	assert.IsType(t, g, sub)
+	assert.ElementsMatch(t, []int{1, 2, 4}, nodeData(sub))
+	assert.Equal(t, map[string]int64{"1>2:": 1, "1>2:fast": 9}, labeledWeights(sub))
+	assert.Equal(t, Attributes{"name": "one"}, getNodeWithVal(sub, 1).Attributes())
+	assert.False(t, sub.Contains(nodes[1]))
+
+	_, err = g.Subgraph(toNodeList(7))
+	internal.AssertErrorEquals(t, errors.New("node 7 not found in the graph"), err)
+
+	fast, err := g.FindEdge(nodes[1], nodes[2], "fast")
+	require.NoError(t, err)
+	fast.Attributes()["speed"] = 100
+
+	tail, err := g.FindEdge(nodes[4], nodes[5], "")
+	require.NoError(t, err)
+
+	sub, err = g.EdgeSubgraph(list.NewArrayList[*Edge[int, int64]](fast, tail))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{1, 2, 4, 5}, nodeData(sub))
+	assert.Equal(t, map[string]int64{"1>2:fast": 9, "4>5:": 5}, labeledWeights(sub))
+
+	e, err := sub.FindEdge(getNodeWithVal(sub, 1), getNodeWithVal(sub, 2), "fast")
+	require.NoError(t, err)
+	assert.Equal(t, Attributes{"speed": 100}, e.Attributes())
+
+	missing := &Edge[int, int64]{from: nodes[5], to: nodes[4]}
+	_, err = g.EdgeSubgraph(list.NewArrayList[*Edge[int, int64]](missing))
+	internal.AssertErrorEquals(t, errors.New("edge 5 to 4 labeled \"\" not found in the graph"), err)
+
+	sub, err = g.Neighborhood(nodes[2], 2)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, nodeData(sub))
+	assert.Equal(t, map[string]int64{"1>2:": 1, "1>2:fast": 9, "2>3:": 2, "3>1:": 3, "3>4:": 4}, labeledWeights(sub))
+
+	sub, err = g.Neighborhood(nodes[4], 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int{4}, nodeData(sub))
+
+	_, err = g.Neighborhood(nodes[4], -1)
+	internal.AssertErrorEquals(t, errors.New("hop count -1 is negative"), err)
+
+	_, err = g.Neighborhood(NewNode[int](7), 1)
+	internal.AssertErrorEquals(t, errors.New("node 7 not found in the graph"), err)
+
+	tr := g.Transpose()
+	assert.IsType(t, g, tr)
+	assert.Equal(t, map[string]int64{"2>1:": 1, "2>1:fast": 9, "3>2:": 2, "1>3:": 3, "4>3:": 4, "5>4:": 5}, labeledWeights(tr))
+
+	co := g.Complement()
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, nodeData(co))
+
+	ok, err := co.AreAdjacent(getNodeWithVal(co, 2), getNodeWithVal(co, 1))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = co.AreAdjacent(getNodeWithVal(co, 1), getNodeWithVal(co, 2))
+	internal.AssertErrorEquals(t, errors.New("edge 1 to 2 not found in the graph"), err)
+	assert.Equal(t, 20-5, len(labeledWeights(co)))
+	assert.False(t, co.HasLoop())
+
+	ct, err := g.Contract(list.NewArrayList[*Node[int, int64]](nodes[1], nodes[2], nodes[3]), 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{0, 4, 5}, nodeData(ct))
+	assert.Equal(t, map[string]int64{"0>4:": 4, "4>5:": 5}, labeledWeights(ct))
+
+	ct, err = g.Contract(list.NewArrayList[*Node[int, int64]](nodes[4], nodes[5]), 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), first(ct.EdgeWeight(getNodeWithVal(ct, 3), getNodeWithVal(ct, 0))))
+
+	_, err = g.Contract(list.NewArrayList[*Node[int, int64]](), 0)
+	internal.AssertErrorEquals(t, errors.New("node list is empty"), err)
+
+	_, err = g.Contract(toNodeList(7), 0)
+	internal.AssertErrorEquals(t, errors.New("node 7 not found in the graph"), err)
+
+	assert.Equal(t, before, labeledWeights(g))
+}
+
+func TestSubgraphsWithUnlabeledParallelEdges(t *testing.T) {
+	forEachGraphKind(t, testSubgraphsWithUnlabeledParallelEdges)
+}
+
+func testSubgraphsWithUnlabeledParallelEdges(t *testing.T, newGraph func() Graph[int, int64]) {
+	g := newGraph()
+
+	a, b, c := NewNode[int](1), NewNode[int](2), NewNode[int](3)
+
+	createWeightedEdge(g, false, a, b, 1)
+	createWeightedEdge(g, false, a, b, 7)
+	createWeightedEdge(g, false, b, c, 2)
+
+	before := edgeList(g)
+
+	sub, err := g.Subgraph(list.NewArrayList[*Node[int, int64]](a, b))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1>2:=1", "1>2:=7"}, edgeList(sub))
+
+	var heavy *Edge[int, int64]
+
+	ei := g.Edges()
+	for ei.HasNext() {
+		e, _ := ei.Next()
+		if e.Weight() == 7 {
+			heavy = e
+		}
+	}
+
+	require.NotNil(t, heavy)
+
+	for _, v := range []*Edge[int, int64]{heavy, {from: a, to: b, weight: 7}} {
+		sub, err = g.EdgeSubgraph(list.NewArrayList[*Edge[int, int64]](v))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1>2:=7"}, edgeList(sub))
+	}
+
+	_, err = g.EdgeSubgraph(list.NewArrayList[*Edge[int, int64]](&Edge[int, int64]{from: a, to: b, weight: 3}))
+	internal.AssertErrorEquals(t, errors.New("edge 1 to 2 labeled \"\" not found in the graph"), err)
+
+	sub, err = g.Neighborhood(a, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1>2:=1", "1>2:=7"}, edgeList(sub))
+
+	assert.Equal(t, []string{"2>1:=1", "2>1:=7", "3>2:=2"}, edgeList(g.Transpose()))
+
+	ct, err := g.Contract(list.NewArrayList[*Node[int, int64]](b, c), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1>0:=1"}, edgeList(ct))
+
+	assert.Equal(t, before, edgeList(g))
+}
+
+func TestUnionAndIntersection(t *testing.T) {
+	a := NewListGraph[int]()
+	subgraphFixture(t, a)
+
+	b := NewMatrixGraph[int]()
+	nodes := make([]*Node[int, int64], 7)
+	for _, i := range []int{2, 3, 6} {
+		nodes[i] = NewNode[int](i)
+		b.AddNode(nodes[i])
+	}
+
+	createWeightedEdge(b, false, nodes[2], nodes[3], 7)
+	createWeightedEdge(b, false, nodes[3], nodes[6], 8)
+	require.NoError(t, b.CreateLabeledDiEdge(nodes[2], nodes[3], "slow", 6))
+
+	u := Union(a, b)
+	assert.IsType(t, a, u)
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6}, nodeData(u))
+	assert.Equal(t, []string{
+		"1>2:=1", "1>2:fast=9", "2>3:=2", "2>3:=7", "2>3:slow=6", "3>1:=3", "3>4:=4", "3>6:=8", "4>5:=5",
+	}, edgeList(u))
+	assert.Equal(t, Attributes{"name": "one"}, getNodeWithVal(u, 1).Attributes())
+
+	in := Intersection(b, a)
+	assert.IsType(t, b, in)
+	assert.ElementsMatch(t, []int{2, 3}, nodeData(in))
+	assert.Equal(t, map[string]int64{"2>3:": 7}, labeledWeights(in))
+}
+
+func TestUnionKeepsUnlabeledParallelEdges(t *testing.T) {
+	a, _ := parallelBiEdgesGraph()
+	b := toMatrixGraph(a)
+
+	nodes := make(map[int]*Node[int, int64])
+	for _, i := range []int{1, 2} {
+		nodes[i] = getNodeWithVal(b, i)
+	}
+
+	createWeightedEdge(b, false, nodes[1], nodes[2], 5)
+	createWeightedEdge(b, false, nodes[1], nodes[2], 9)
+
+	assert.Equal(t, edgeList(a), edgeList(Union(a, toMatrixGraph(a))))
+	assert.Equal(t, edgeList(b), edgeList(Union(a, b)))
+	assert.Equal(t, edgeList(b), edgeList(Union(b, a)))
+}
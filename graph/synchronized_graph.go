@@ -0,0 +1,419 @@
+package graph
+
+import (
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	"github.com/nsnikhil/go-datastructures/list"
+	"reflect"
+	"sync"
+)
+
+// SynchronizedGraph guards a graph with a read write lock, the methods changing
+// the graph hold the write lock and every other one the read lock. Iterators walk
+// a copy taken under the read lock, and the graphs returned by the other methods
+// are not synchronized themselves. Visitor callbacks run under the read lock so
+// they must not change the graph. Every package level function taking a graph,
+// like EdmondsKarp, Union or WriteJSON, holds the read lock as well. Nodes, edges
+// and their attributes handed out are not guarded either.
+type SynchronizedGraph[T any, W Weight] struct {
+	mu sync.RWMutex
+	g  Graph[T, W]
+}
+
+func NewSynchronizedGraph[T any, W Weight](g Graph[T, W]) *SynchronizedGraph[T, W] {
+	return &SynchronizedGraph[T, W]{g: g}
+}
+
+// readLocked returns the graph g wraps holding its read lock when g is
+// synchronized, and g itself otherwise, along with the function releasing it.
+func readLocked[T any, W Weight](g Graph[T, W]) (Graph[T, W], func()) {
+	if sg, ok := g.(*SynchronizedGraph[T, W]); ok {
+		sg.mu.RLock()
+		return sg.g, sg.mu.RUnlock
+	}
+
+	return g, func() {}
+}
+
+// readLockedPair is readLocked for two graphs, it takes the lock of a graph passed
+// twice once and the locks of two graphs in the order of their addresses, so calls
+// with the same graphs swapped cannot wait on each other behind a writer.
+func readLockedPair[T any, W Weight](a, b Graph[T, W]) (Graph[T, W], Graph[T, W], func()) {
+	sa, aok := a.(*SynchronizedGraph[T, W])
+	sb, bok := b.(*SynchronizedGraph[T, W])
+
+	if aok && bok && sa == sb {
+		sa.mu.RLock()
+		return sa.g, sa.g, sa.mu.RUnlock
+	}
+
+	if aok && bok && reflect.ValueOf(sb).Pointer() < reflect.ValueOf(sa).Pointer() {
+		b, unlockB := readLocked(b)
+		a, unlockA := readLocked(a)
+		return a, b, func() { unlockA(); unlockB() }
+	}
+
+	a, unlockA := readLocked(a)
+	b, unlockB := readLocked(b)
+	return a, b, func() { unlockB(); unlockA() }
+}
+
+func snapshot[E comparable](it iterator.Iterator[E]) iterator.Iterator[E] {
+	res := list.NewArrayList[E]()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res.Add(e)
+	}
+
+	return res.Iterator()
+}
+
+func (sg *SynchronizedGraph[T, W]) Nodes() iterator.Iterator[*Node[T, W]] {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return snapshot(sg.g.Nodes())
+}
+
+func (sg *SynchronizedGraph[T, W]) Edges() iterator.Iterator[*Edge[T, W]] {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return snapshot(sg.g.Edges())
+}
+
+func (sg *SynchronizedGraph[T, W]) DFSIterator() iterator.Iterator[*Node[T, W]] {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return snapshot(sg.g.DFSIterator())
+}
+
+func (sg *SynchronizedGraph[T, W]) BFSIterator() iterator.Iterator[*Node[T, W]] {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return snapshot(sg.g.BFSIterator())
+}
+
+func (sg *SynchronizedGraph[T, W]) AddNode(n *Node[T, W]) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	sg.g.AddNode(n)
+}
+
+func (sg *SynchronizedGraph[T, W]) CreateDiEdge(curr *Node[T, W], next *Node[T, W]) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	return sg.g.CreateDiEdge(curr, next)
+}
+
+func (sg *SynchronizedGraph[T, W]) CreateWeightedDiEdge(curr, next *Node[T, W], weight W) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	return sg.g.CreateWeightedDiEdge(curr, next, weight)
+}
+
+func (sg *SynchronizedGraph[T, W]) CreateBiEdge(curr *Node[T, W], next *Node[T, W]) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	return sg.g.CreateBiEdge(curr, next)
+}
+
+func (sg *SynchronizedGraph[T, W]) CreateWeightedBiEdge(curr, nodes *Node[T, W], weight W) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	return sg.g.CreateWeightedBiEdge(curr, nodes, weight)
+}
+
+func (sg *SynchronizedGraph[T, W]) CreateLabeledDiEdge(curr, next *Node[T, W], label string, weight W) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	return sg.g.CreateLabeledDiEdge(curr, next, label, weight)
+}
+
+func (sg *SynchronizedGraph[T, W]) CreateLabeledBiEdge(curr, next *Node[T, W], label string, weight W) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	return sg.g.CreateLabeledBiEdge(curr, next, label, weight)
+}
+
+func (sg *SynchronizedGraph[T, W]) DeleteNode(n *Node[T, W]) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	return sg.g.DeleteNode(n)
+}
+
+func (sg *SynchronizedGraph[T, W]) DeleteEdge(start, end *Node[T, W]) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	return sg.g.DeleteEdge(start, end)
+}
+
+func (sg *SynchronizedGraph[T, W]) DeleteLabeledEdge(start, end *Node[T, W], label string) error {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	return sg.g.DeleteLabeledEdge(start, end, label)
+}
+
+func (sg *SynchronizedGraph[T, W]) Contains(n *Node[T, W]) bool {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.Contains(n)
+}
+
+func (sg *SynchronizedGraph[T, W]) ParallelBFS(source *Node[T, W], workers int) ([]list.List[*Node[T, W]], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.ParallelBFS(source, workers)
+}
+
+func (sg *SynchronizedGraph[T, W]) HasLoop() bool {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.HasLoop()
+}
+
+func (sg *SynchronizedGraph[T, W]) HasCycle() bool {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.HasCycle()
+}
+
+func (sg *SynchronizedGraph[T, W]) AreAdjacent(a, b *Node[T, W]) (bool, error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.AreAdjacent(a, b)
+}
+
+func (sg *SynchronizedGraph[T, W]) EdgeWeight(a, b *Node[T, W]) (W, error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.EdgeWeight(a, b)
+}
+
+func (sg *SynchronizedGraph[T, W]) FindEdge(a, b *Node[T, W], label string) (*Edge[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.FindEdge(a, b, label)
+}
+
+func (sg *SynchronizedGraph[T, W]) EdgesBetween(a, b *Node[T, W]) (list.List[*Edge[T, W]], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.EdgesBetween(a, b)
+}
+
+func (sg *SynchronizedGraph[T, W]) InDegreeOfNode(a *Node[T, W]) (int64, error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.InDegreeOfNode(a)
+}
+
+func (sg *SynchronizedGraph[T, W]) OutDegreeOfNode(a *Node[T, W]) (int64, error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.OutDegreeOfNode(a)
+}
+
+func (sg *SynchronizedGraph[T, W]) Reverse() {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	sg.g.Reverse()
+}
+
+// Clone returns a synchronized copy of the graph.
+func (sg *SynchronizedGraph[T, W]) Clone() Graph[T, W] {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return NewSynchronizedGraph[T, W](sg.g.Clone())
+}
+
+func (sg *SynchronizedGraph[T, W]) Subgraph(nodes list.List[*Node[T, W]]) (Graph[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.Subgraph(nodes)
+}
+
+func (sg *SynchronizedGraph[T, W]) EdgeSubgraph(edges list.List[*Edge[T, W]]) (Graph[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.EdgeSubgraph(edges)
+}
+
+func (sg *SynchronizedGraph[T, W]) Neighborhood(n *Node[T, W], k int) (Graph[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.Neighborhood(n, k)
+}
+
+func (sg *SynchronizedGraph[T, W]) Contract(nodes list.List[*Node[T, W]], data T) (Graph[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.Contract(nodes, data)
+}
+
+func (sg *SynchronizedGraph[T, W]) Complement() Graph[T, W] {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.Complement()
+}
+
+func (sg *SynchronizedGraph[T, W]) Transpose() Graph[T, W] {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.Transpose()
+}
+
+func (sg *SynchronizedGraph[T, W]) HasRoute(source, target *Node[T, W]) (bool, error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.HasRoute(source, target)
+}
+
+func (sg *SynchronizedGraph[T, W]) GetConnectedComponents() []list.List[*Node[T, W]] {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.GetConnectedComponents()
+}
+
+func (sg *SynchronizedGraph[T, W]) StronglyConnectedComponents() []list.List[*Node[T, W]] {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.StronglyConnectedComponents()
+}
+
+func (sg *SynchronizedGraph[T, W]) WeaklyConnectedComponents() []list.List[*Node[T, W]] {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.WeaklyConnectedComponents()
+}
+
+func (sg *SynchronizedGraph[T, W]) Bridges() (list.List[*Edge[T, W]], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.Bridges()
+}
+
+func (sg *SynchronizedGraph[T, W]) ArticulationPoints() (list.List[*Node[T, W]], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.ArticulationPoints()
+}
+
+func (sg *SynchronizedGraph[T, W]) BiconnectedComponents() ([]list.List[*Node[T, W]], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.BiconnectedComponents()
+}
+
+func (sg *SynchronizedGraph[T, W]) ShortestPath(source, target *Node[T, W], properties ...Property) (list.List[*Node[T, W]], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.ShortestPath(source, target, properties...)
+}
+
+func (sg *SynchronizedGraph[T, W]) ShortestPathTree(source *Node[T, W], properties ...Property) (*ShortestPathTree[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.ShortestPathTree(source, properties...)
+}
+
+func (sg *SynchronizedGraph[T, W]) FloydWarshall() (*AllPairsShortestPaths[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.FloydWarshall()
+}
+
+func (sg *SynchronizedGraph[T, W]) Johnson() (*AllPairsShortestPaths[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.Johnson()
+}
+
+func (sg *SynchronizedGraph[T, W]) AStar(source, target *Node[T, W], heuristic Heuristic[T, W]) (*PathResult[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.AStar(source, target, heuristic)
+}
+
+func (sg *SynchronizedGraph[T, W]) BidirectionalDijkstra(source, target *Node[T, W]) (*PathResult[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.BidirectionalDijkstra(source, target)
+}
+
+func (sg *SynchronizedGraph[T, W]) BidirectionalBFS(source, target *Node[T, W]) (*PathResult[T, W], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.BidirectionalBFS(source, target)
+}
+
+func (sg *SynchronizedGraph[T, W]) KruskalMST() (Graph[T, W], W, error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.KruskalMST()
+}
+
+func (sg *SynchronizedGraph[T, W]) PrimMST() (Graph[T, W], W, error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.PrimMST()
+}
+
+func (sg *SynchronizedGraph[T, W]) TopologicalSort() (list.List[*Node[T, W]], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.TopologicalSort()
+}
+
+func (sg *SynchronizedGraph[T, W]) TopologicalLayers() ([]list.List[*Node[T, W]], error) {
+	sg.mu.RLock()
+	defer sg.mu.RUnlock()
+
+	return sg.g.TopologicalLayers()
+}
@@ -0,0 +1,306 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestSynchronizedGraphConcurrentAccess(t *testing.T) {
+	g := NewSynchronizedGraph[int, int64](NewListGraph[int]())
+
+	root := NewNode[int](0)
+	g.AddNode(root)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(2)
+
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 1; i <= 25; i++ {
+				n := NewNode[int](w*100 + i)
+				g.AddNode(n)
+				assert.NoError(t, g.CreateWeightedBiEdge(root, n, int64(i)))
+			}
+		}(w)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 25; i++ {
+				assert.True(t, g.Contains(root))
+
+				it := g.Nodes()
+				for it.HasNext() {
+					n, _ := it.Next()
+					if n != root {
+						_, _ = g.EdgeWeight(root, n)
+					}
+				}
+
+				_, err := g.ParallelBFS(root, 2)
+				assert.NoError(t, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, 101, len(nodeData(g)))
+
+	out, err := g.OutDegreeOfNode(root)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), out)
+}
+
+func TestSynchronizedGraphConcurrentConnectedComponents(t *testing.T) {
+	for i, lg := range getAllGraphs() {
+		g := NewSynchronizedGraph[int, int64](lg)
+
+		expected := len(lg.GetConnectedComponents())
+		before := labeledWeights(g)
+
+		var wg sync.WaitGroup
+
+		for w := 0; w < 8; w++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for j := 0; j < 10; j++ {
+					assert.Equal(t, expected, len(g.GetConnectedComponents()), "graph %d", i)
+					assert.Equal(t, before, labeledWeights(g), "graph %d", i)
+				}
+			}()
+		}
+
+		wg.Wait()
+	}
+}
+
+func TestSynchronizedGraphPackageAlgorithms(t *testing.T) {
+	g := NewSynchronizedGraph[int, int64](NewListGraph[int]())
+
+	source, sink := NewNode[int](0), NewNode[int](1)
+	g.AddNode(source)
+	g.AddNode(sink)
+
+	require.NoError(t, g.CreateWeightedDiEdge(source, sink, 1))
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(2)
+
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 2; i < 27; i++ {
+				n := NewNode[int](w*100 + i)
+				g.AddNode(n)
+				assert.NoError(t, g.CreateWeightedDiEdge(source, n, 1))
+				assert.NoError(t, g.CreateWeightedDiEdge(n, sink, 1))
+			}
+		}(w)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 25; i++ {
+				fr, err := Dinic[int, int64](g, source, sink)
+				assert.NoError(t, err)
+
+				ek, err := EdmondsKarp[int, int64](g, source, sink)
+				assert.NoError(t, err)
+
+				assert.LessOrEqual(t, fr.Value(), ek.Value())
+
+				_, err = BipartiteMatching[int, int64](g, list.NewArrayList[*Node[int, int64]](source))
+				assert.NoError(t, err)
+
+				assert.Empty(t, ElementaryCycles[int, int64](g))
+
+				_, err = FindCycle[int, int64](g)
+				assert.Error(t, err)
+
+				_, err = GreedyColoring[int, int64](g)
+				assert.NoError(t, err)
+
+				_, err = PageRank[int, int64](g, 0.85, 1e-6)
+				assert.NoError(t, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	fr, err := Dinic[int, int64](g, source, sink)
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), fr.Value())
+}
+
+func TestSynchronizedGraphGraphFunctions(t *testing.T) {
+	g := NewSynchronizedGraph[int, int64](NewListGraph[int]())
+	other := NewSynchronizedGraph[int, int64](NewMatrixGraph[int]())
+
+	root := NewNode[int](0)
+	g.AddNode(root)
+	other.AddNode(NewNode[int](0))
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(2)
+
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 1; i <= 25; i++ {
+				n := NewNode[int](w*100 + i)
+				g.AddNode(n)
+				assert.NoError(t, g.CreateDiEdge(root, n))
+				assert.NoError(t, g.CreateDiEdge(n, root))
+			}
+		}(w)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 10; i++ {
+				assert.True(t, Equal[int, int64](g, g))
+				assert.True(t, IsIsomorphic[int, int64](g, g, nil))
+
+				_, err := Isomorphism[int, int64](g, g, nil)
+				assert.NoError(t, err)
+
+				_, err = SubgraphIsomorphisms[int, int64](g, other, nil, 1)
+				assert.NoError(t, err)
+
+				assert.True(t, Condensation[int, int64](g).Nodes().HasNext())
+
+				assert.NotEmpty(t, nodeData(Union[int, int64](g, other)))
+				assert.NotEmpty(t, nodeData(Union[int, int64](other, g)))
+				assert.Equal(t, []int{0}, nodeData(Intersection[int, int64](other, g)))
+
+				assert.NoError(t, WriteJSON[int, int64](io.Discard, g, nil))
+				assert.NoError(t, WriteEdgeList[int, int64](io.Discard, g, nil))
+				assert.NoError(t, WriteDOT[int, int64](io.Discard, g, nil))
+				assert.NoError(t, WriteGraphML[int, int64](io.Discard, g, nil))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, 101, len(nodeData(Union[int, int64](g, other))))
+}
+
+func TestSynchronizedGraphDelegates(t *testing.T) {
+	for i, lg := range getAllGraphs() {
+		g := NewSynchronizedGraph[int, int64](lg)
+
+		assert.Equal(t, simplifyGraph(lg), simplifyGraph(g), "graph %d", i)
+		assert.Equal(t, lg.HasCycle(), g.HasCycle(), "graph %d", i)
+		assert.Equal(t, len(labeledWeights(lg)), len(labeledWeights(g)), "graph %d", i)
+
+		cl := g.Clone()
+		assert.IsType(t, &SynchronizedGraph[int, int64]{}, cl)
+		assert.Equal(t, simplifyGraph(lg), simplifyGraph(cl), "graph %d", i)
+	}
+
+	g := NewSynchronizedGraph[int, int64](NewMatrixGraph[int]())
+
+	a := NewNode[int](1)
+	b := NewNode[int](2)
+
+	g.AddNode(a)
+	g.AddNode(b)
+
+	require.NoError(t, g.CreateLabeledDiEdge(a, b, "road", 3))
+
+	e, err := g.FindEdge(a, b, "road")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), e.Weight())
+
+	require.NoError(t, g.DeleteNode(b))
+	assert.False(t, g.Contains(b))
+
+	internal.AssertErrorEquals(t, errors.New("node 2 not found in the graph"), g.DeleteEdge(a, b))
+}
+
+func TestParallelBFS(t *testing.T) {
+	layersByDepth := func(g Graph[int, int64], source *Node[int, int64]) [][]int {
+		res := make([][]int, 0)
+
+		err := WalkBFS(context.Background(), g, Visitor[int, int64]{
+			OnDiscover: func(n *Node[int, int64], depth int) bool {
+				if depth == len(res) {
+					res = append(res, []int{})
+				}
+
+				res[depth] = append(res[depth], n.Data())
+				return true
+			},
+		}, WalkOptions[int, int64]{Start: source})
+		require.NoError(t, err)
+
+		for _, l := range res {
+			sort.Ints(l)
+		}
+
+		return res
+	}
+
+	graphs := getAllGraphs()
+	graphs = append(graphs, toMatrixGraph(graphs[len(graphs)-1]))
+
+	for i, g := range graphs {
+		it := g.Nodes()
+		for it.HasNext() {
+			source, _ := it.Next()
+			expected := layersByDepth(g, source)
+
+			for _, workers := range []int{1, 3, 8} {
+				layers, err := g.ParallelBFS(source, workers)
+				require.NoError(t, err)
+
+				actual := make([][]int, 0, len(layers))
+				for _, l := range layers {
+					data := make([]int, 0)
+
+					li := l.Iterator()
+					for li.HasNext() {
+						n, _ := li.Next()
+						data = append(data, n.Data())
+					}
+
+					sort.Ints(data)
+					actual = append(actual, data)
+				}
+
+				assert.Equal(t, expected, actual, "graph %d source %d workers %d", i, source.Data(), workers)
+			}
+		}
+	}
+
+	g := NewListGraph[int]()
+	a := NewNode[int](1)
+	g.AddNode(a)
+
+	_, err := g.ParallelBFS(NewNode[int](2), 1)
+	internal.AssertErrorEquals(t, errors.New("node 2 not found in the graph"), err)
+
+	_, err = g.ParallelBFS(a, 0)
+	internal.AssertErrorEquals(t, errors.New("invalid workers 0"), err)
+}
@@ -8,6 +8,16 @@ func (ps propertySet) hasProperty(p Property) bool {
 	return ps.hm[p]
 }
 
+func (ps propertySet) properties() []Property {
+	res := make([]Property, 0, len(ps.hm))
+
+	for p := range ps.hm {
+		res = append(res, p)
+	}
+
+	return res
+}
+
 func newPropertySet(properties ...Property) propertySet {
 	data := make(map[Property]bool)
 
@@ -20,7 +30,7 @@ func newPropertySet(properties ...Property) propertySet {
 	}
 }
 
-func graphOne() (Graph[int], propertySet) {
+func graphOne() (Graph[int, int64], propertySet) {
 	// ONE
 	//
 	//  6 <-- 4
@@ -42,7 +52,7 @@ func graphOne() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, UnWeighted, cyclic, connected, stronglyConnected)
 }
 
-func graphOneReverse() (Graph[int], propertySet) {
+func graphOneReverse() (Graph[int, int64], propertySet) {
 	// ONE
 	//
 	//  6 --> 4
@@ -64,7 +74,7 @@ func graphOneReverse() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, UnWeighted, cyclic, connected, stronglyConnected)
 }
 
-func graphTwo() (Graph[int], propertySet) {
+func graphTwo() (Graph[int, int64], propertySet) {
 	// TWO
 	//
 	//  0  --> 1
@@ -86,7 +96,7 @@ func graphTwo() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, UnWeighted, cyclic, connected, stronglyConnected)
 }
 
-func graphThree() (Graph[int], propertySet) {
+func graphThree() (Graph[int, int64], propertySet) {
 	// THREE
 	//
 	//  0  --> 1
@@ -108,7 +118,7 @@ func graphThree() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, UnWeighted, cyclic, connected, weaklyConnected)
 }
 
-func graphFour() (Graph[int], propertySet) {
+func graphFour() (Graph[int, int64], propertySet) {
 	// FOUR
 	//
 	//  0  --> 1 <-- 2
@@ -132,7 +142,7 @@ func graphFour() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, UnWeighted, cyclic, connected, weaklyConnected)
 }
 
-func graphFive() (Graph[int], propertySet) {
+func graphFive() (Graph[int, int64], propertySet) {
 	// FIVE
 	//
 	//            0 --> 1
@@ -161,7 +171,7 @@ func graphFive() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, UnWeighted, cyclic, connected, weaklyConnected)
 }
 
-func graphSix() (Graph[int], propertySet) {
+func graphSix() (Graph[int, int64], propertySet) {
 	// SIX
 	//
 	//  6 --- 4
@@ -180,7 +190,7 @@ func graphSix() (Graph[int], propertySet) {
 	return g, newPropertySet(unDirected, UnWeighted, cyclic, connected)
 }
 
-func graphSeven() (Graph[int], propertySet) {
+func graphSeven() (Graph[int, int64], propertySet) {
 	// SEVEN
 	//
 	//  0  --- 1
@@ -200,7 +210,7 @@ func graphSeven() (Graph[int], propertySet) {
 	return g, newPropertySet(unDirected, UnWeighted, cyclic, connected)
 }
 
-func graphEight() (Graph[int], propertySet) {
+func graphEight() (Graph[int, int64], propertySet) {
 	// EIGHT
 	//
 	//  0 --- 1 --- 2
@@ -223,7 +233,7 @@ func graphEight() (Graph[int], propertySet) {
 	return g, newPropertySet(unDirected, UnWeighted, cyclic, connected)
 }
 
-func graphNine() (Graph[int], propertySet) {
+func graphNine() (Graph[int, int64], propertySet) {
 	// NINE
 	//
 	//            0 --- 1
@@ -250,7 +260,7 @@ func graphNine() (Graph[int], propertySet) {
 	return g, newPropertySet(unDirected, UnWeighted, cyclic, connected)
 }
 
-func graphTen() (Graph[int], propertySet) {
+func graphTen() (Graph[int, int64], propertySet) {
 	// ONE
 	//     2
 	//  6 <-- 4
@@ -271,7 +281,7 @@ func graphTen() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, weighted, cyclic, connected, stronglyConnected)
 }
 
-func graphEleven() (Graph[int], propertySet) {
+func graphEleven() (Graph[int, int64], propertySet) {
 	// TWO
 	//
 	//      2
@@ -295,7 +305,7 @@ func graphEleven() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, weighted, cyclic, connected, stronglyConnected)
 }
 
-func graphTwelve() (Graph[int], propertySet) {
+func graphTwelve() (Graph[int, int64], propertySet) {
 	// THREE
 	//
 	//      2
@@ -319,7 +329,7 @@ func graphTwelve() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, weighted, cyclic, connected, weaklyConnected)
 }
 
-func graphThirteen() (Graph[int], propertySet) {
+func graphThirteen() (Graph[int, int64], propertySet) {
 	// FOUR
 	//       2      3
 	//    0  --> 1 <-- 2
@@ -348,7 +358,7 @@ func graphThirteen() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, weighted, cyclic, connected, weaklyConnected)
 }
 
-func graphFourteen() (Graph[int], propertySet) {
+func graphFourteen() (Graph[int, int64], propertySet) {
 	// FIVE
 	//               4
 	//            0 --> 1
@@ -378,7 +388,7 @@ func graphFourteen() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, weighted, cyclic, connected, weaklyConnected)
 }
 
-func graphFifteen() (Graph[int], propertySet) {
+func graphFifteen() (Graph[int, int64], propertySet) {
 	// ONE
 	//     2
 	//  6 --- 4
@@ -399,7 +409,7 @@ func graphFifteen() (Graph[int], propertySet) {
 	return g, newPropertySet(unDirected, weighted, cyclic, connected)
 }
 
-func graphSixteen() (Graph[int], propertySet) {
+func graphSixteen() (Graph[int, int64], propertySet) {
 	// TWO
 	//
 	//      2
@@ -423,7 +433,7 @@ func graphSixteen() (Graph[int], propertySet) {
 	return g, newPropertySet(unDirected, weighted, cyclic, connected)
 }
 
-func graphSeventeen() (Graph[int], propertySet) {
+func graphSeventeen() (Graph[int, int64], propertySet) {
 	// FOUR
 	//       2      3
 	//    0  --- 1 --- 2
@@ -452,7 +462,7 @@ func graphSeventeen() (Graph[int], propertySet) {
 	return g, newPropertySet(unDirected, weighted, cyclic, connected)
 }
 
-func graphEighteen() (Graph[int], propertySet) {
+func graphEighteen() (Graph[int, int64], propertySet) {
 	// FIVE
 	//               4
 	//            0 --- 1
@@ -482,7 +492,7 @@ func graphEighteen() (Graph[int], propertySet) {
 	return g, newPropertySet(unDirected, weighted, cyclic, connected)
 }
 
-func graphNineTeen() (Graph[int], propertySet) {
+func graphNineTeen() (Graph[int, int64], propertySet) {
 	// ONE
 	//             2
 	//          6 <-- 4
@@ -506,7 +516,7 @@ func graphNineTeen() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, weighted, ACyclic, connected, weaklyConnected)
 }
 
-func graphTwenty() (Graph[int], propertySet) {
+func graphTwenty() (Graph[int, int64], propertySet) {
 	// TWO
 	//
 	//      2
@@ -530,7 +540,7 @@ func graphTwenty() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, weighted, ACyclic, connected, weaklyConnected)
 }
 
-func graphTwentyOne() (Graph[int], propertySet) {
+func graphTwentyOne() (Graph[int, int64], propertySet) {
 	// FOUR
 	//       2      5
 	//    0  --> 1 --> 2
@@ -559,7 +569,7 @@ func graphTwentyOne() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, weighted, ACyclic, connected, weaklyConnected)
 }
 
-func graphTwentyTwo() (Graph[int], propertySet) {
+func graphTwentyTwo() (Graph[int, int64], propertySet) {
 	// FIVE
 	//               4
 	//            0 --> 1
@@ -588,7 +598,7 @@ func graphTwentyTwo() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, weighted, ACyclic, connected, weaklyConnected)
 }
 
-func graphTwentyThree() (Graph[int], propertySet) {
+func graphTwentyThree() (Graph[int, int64], propertySet) {
 	// TWENTY THREE
 	//
 	//             -6
@@ -613,7 +623,7 @@ func graphTwentyThree() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, weighted, cyclic, connected, weaklyConnected, negativeWeights, negativeCycles)
 }
 
-func graphTwentyFour() (Graph[int], propertySet) {
+func graphTwentyFour() (Graph[int, int64], propertySet) {
 	// TWENTY FOUR
 	//
 	// 0 ---- 1 ---- 2 ---- 3      8 ---- 9      10 ---- 11
@@ -629,7 +639,7 @@ func graphTwentyFour() (Graph[int], propertySet) {
 	g := NewListGraph[int]()
 
 	sz := 15
-	nodes := make([]*Node[int], sz)
+	nodes := make([]*Node[int, int64], sz)
 	for i := 0; i < sz; i++ {
 		nodes[i] = NewNode[int](i)
 		g.AddNode(nodes[i])
@@ -657,7 +667,7 @@ func graphTwentyFour() (Graph[int], propertySet) {
 	return g, newPropertySet(unDirected, UnWeighted, cyclic, disConnected)
 }
 
-func graphTwentyFive() (Graph[int], propertySet) {
+func graphTwentyFive() (Graph[int, int64], propertySet) {
 	// TWENTY FOUR
 	//
 	// 0 ---> 1 ---> 2 ---> 3      8 ---> 9      10 <--- 11
@@ -673,7 +683,7 @@ func graphTwentyFive() (Graph[int], propertySet) {
 	g := NewListGraph[int]()
 
 	sz := 15
-	nodes := make([]*Node[int], sz)
+	nodes := make([]*Node[int, int64], sz)
 	for i := 0; i < sz; i++ {
 		nodes[i] = NewNode[int](i)
 		g.AddNode(nodes[i])
@@ -701,7 +711,7 @@ func graphTwentyFive() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, UnWeighted, cyclic, disConnected)
 }
 
-func graphTwentyFiveReverse() (Graph[int], propertySet) {
+func graphTwentyFiveReverse() (Graph[int, int64], propertySet) {
 	// TWENTY FOUR
 	//
 	// 0 <--- 1 <--- 2 <--- 3      8 <--- 9      10 ---> 11
@@ -717,7 +727,7 @@ func graphTwentyFiveReverse() (Graph[int], propertySet) {
 	g := NewListGraph[int]()
 
 	sz := 15
-	nodes := make([]*Node[int], sz)
+	nodes := make([]*Node[int, int64], sz)
 	for i := 0; i < sz; i++ {
 		nodes[i] = NewNode[int](i)
 		g.AddNode(nodes[i])
@@ -745,25 +755,64 @@ func graphTwentyFiveReverse() (Graph[int], propertySet) {
 	return g, newPropertySet(Directed, UnWeighted, cyclic, disConnected)
 }
 
+func graphGrid(rows, cols int, blocked ...int) Graph[int, int64] {
+	// GRID
+	//
+	//  0 -- 1 -- 2
+	//  |    |    |
+	//  3 -- 4 -- 5
+	//
+	// cells are numbered row by row, neighbouring cells are joined both ways with
+	// a weight between 1 and 3, blocked cells are left out of the graph.
+
+	isBlocked := make(map[int]bool)
+	for _, b := range blocked {
+		isBlocked[b] = true
+	}
+
+	g := NewListGraph[int]()
+	nodes := make([]*Node[int, int64], rows*cols)
+
+	for i := range nodes {
+		if !isBlocked[i] {
+			nodes[i] = NewNode[int](i)
+			g.AddNode(nodes[i])
+		}
+	}
+
+	join := func(a, b int) {
+		if nodes[a] != nil && nodes[b] != nil {
+			createWeightedEdge(g, true, nodes[a], nodes[b], int64(1+(a+b)%3))
+		}
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if c+1 < cols {
+				join(r*cols+c, r*cols+c+1)
+			}
+
+			if r+1 < rows {
+				join(r*cols+c, (r+1)*cols+c)
+			}
+		}
+	}
+
+	return g
+}
+
 type graphSet[T comparable] struct {
-	g  Graph[T]
+	g  Graph[T, int64]
 	ps propertySet
 }
 
-func getGraphs[T comparable](properties ...Property) []Graph[int] {
+func getGraphs[T comparable](properties ...Property) []Graph[int, int64] {
 	if len(properties) == 0 {
-		return []Graph[int]{}
-	}
-
-	toGS := func(g Graph[int], ps propertySet) graphSet[int] {
-		return graphSet[int]{
-			g:  g,
-			ps: ps,
-		}
+		return []Graph[int, int64]{}
 	}
 
-	filter := func(gs []graphSet[int], p ...Property) []Graph[int] {
-		var res []Graph[int]
+	filter := func(gs []graphSet[int], p ...Property) []Graph[int, int64] {
+		var res []Graph[int, int64]
 
 		for _, g := range gs {
 
@@ -783,8 +832,18 @@ func getGraphs[T comparable](properties ...Property) []Graph[int] {
 		return res
 	}
 
-	gs := make([]graphSet[int], 0)
-	gs = append(gs,
+	return filter(getAllGraphSets(), properties...)
+}
+
+func getAllGraphSets() []graphSet[int] {
+	toGS := func(g Graph[int, int64], ps propertySet) graphSet[int] {
+		return graphSet[int]{
+			g:  g,
+			ps: ps,
+		}
+	}
+
+	return []graphSet[int]{
 		toGS(graphOne()), toGS(graphOneReverse()), toGS(graphTwo()), toGS(graphThree()), toGS(graphFour()),
 		toGS(graphFive()), toGS(graphSix()), toGS(graphSeven()), toGS(graphEight()),
 		toGS(graphNine()), toGS(graphTen()), toGS(graphEleven()), toGS(graphTwelve()),
@@ -792,15 +851,35 @@ func getGraphs[T comparable](properties ...Property) []Graph[int] {
 		toGS(graphSeventeen()), toGS(graphEighteen()), toGS(graphNineTeen()), toGS(graphTwenty()),
 		toGS(graphTwentyOne()), toGS(graphTwentyTwo()), toGS(graphTwentyThree()), toGS(graphTwentyFour()),
 		toGS(graphTwentyFive()), toGS(graphTwentyFiveReverse()),
-	)
+	}
+}
+
+func toMatrixGraph(g Graph[int, int64]) Graph[int, int64] {
+	res := NewMatrixGraph[int]()
+	nodes := make(map[*Node[int, int64]]*Node[int, int64])
+
+	ni := g.Nodes()
+	for ni.HasNext() {
+		n, _ := ni.Next()
+		nodes[n] = NewNode[int](n.data)
+		res.AddNode(nodes[n])
+	}
+
+	ei := g.Edges()
+	for ei.HasNext() {
+		e, _ := ei.Next()
+		if err := res.CreateWeightedDiEdge(nodes[e.From()], nodes[e.To()], e.Weight()); err != nil {
+			panic(err)
+		}
+	}
 
-	return filter(gs, properties...)
+	return res
 }
 
-func getAllGraphs() []Graph[int] {
-	f := func(g Graph[int], ps propertySet) Graph[int] { return g }
+func getAllGraphs() []Graph[int, int64] {
+	f := func(g Graph[int, int64], ps propertySet) Graph[int, int64] { return g }
 
-	return []Graph[int]{
+	return []Graph[int, int64]{
 		f(graphOne()), f(graphOneReverse()), f(graphTwo()), f(graphThree()), f(graphFour()),
 		f(graphFive()), f(graphSix()), f(graphSeven()), f(graphEight()),
 		f(graphNine()), f(graphTen()), f(graphEleven()), f(graphTwelve()),
@@ -811,13 +890,13 @@ func getAllGraphs() []Graph[int] {
 	}
 }
 
-func createEdge(g Graph[int], isBidirected bool, source *Node[int], targets ...*Node[int]) {
+func createEdge(g Graph[int, int64], isBidirected bool, source *Node[int, int64], targets ...*Node[int, int64]) {
 	for _, target := range targets {
 		createWeightedEdge(g, isBidirected, source, target, 0)
 	}
 }
 
-func createWeightedEdge(g Graph[int], isBidirected bool, source *Node[int], target *Node[int], weight int64) {
+func createWeightedEdge(g Graph[int, int64], isBidirected bool, source *Node[int, int64], target *Node[int, int64], weight int64) {
 	if !g.Contains(source) {
 		g.AddNode(source)
 	}
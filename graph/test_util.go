@@ -7,7 +7,7 @@ import (
 )
 
 //TODO: FAILS FOR CYCLE
-func areNodeEqual(a, b *Node[int]) bool {
+func areNodeEqual(a, b *Node[int, int64]) bool {
 	if a == nil && b == nil {
 		return true
 	}
@@ -19,7 +19,7 @@ func areNodeEqual(a, b *Node[int]) bool {
 	return true
 }
 
-func areEdgesEqual(a, b set.Set[*edge[int]]) bool {
+func areEdgesEqual(a, b set.Set[*edge[int, int64]]) bool {
 	if a.Size() != b.Size() {
 		return false
 	}
@@ -52,12 +52,12 @@ func areEdgesEqual(a, b set.Set[*edge[int]]) bool {
 
 type edgeComparator struct{}
 
-func (e edgeComparator) Compare(a, b *edge[int]) int {
+func (e edgeComparator) Compare(a, b *edge[int, int64]) int {
 	return a.next.data - b.next.data
 }
 
-func getKeys(s set.Set[*edge[int]]) list.List[*edge[int]] {
-	res := list.NewArrayList[*edge[int]]()
+func getKeys(s set.Set[*edge[int, int64]]) list.List[*edge[int, int64]] {
+	res := list.NewArrayList[*edge[int, int64]]()
 
 	it := s.Iterator()
 	for it.HasNext() {
@@ -70,10 +70,10 @@ func getKeys(s set.Set[*edge[int]]) list.List[*edge[int]] {
 	return res
 }
 
-func simplifyGraph(g *listGraph[int]) map[int][]int {
+func simplifyGraph(g Graph[int, int64]) map[int][]int {
 	res := make(map[int][]int)
 
-	ni := g.nodes.Iterator()
+	ni := g.Nodes()
 	for ni.HasNext() {
 		n, _ := ni.Next()
 
@@ -114,8 +114,8 @@ func (isc intSliceComparator) Compare(a, b []int) int {
 	return 0
 }
 
-func getNodeWithVal(g Graph[int], val int) *Node[int] {
-	it := g.(*listGraph[int]).nodes.Iterator()
+func getNodeWithVal(g Graph[int, int64], val int) *Node[int, int64] {
+	it := g.Nodes()
 	for it.HasNext() {
 		n, _ := it.Next()
 		if n.data == val {
@@ -127,12 +127,12 @@ func getNodeWithVal(g Graph[int], val int) *Node[int] {
 }
 
 //TODO: REFACTOR
-func areComponentsEqual(a, b []list.List[*Node[int]]) bool {
+func areComponentsEqual(a, b []list.List[*Node[int, int64]]) bool {
 	if len(a) != len(b) {
 		return false
 	}
 
-	toSlice := func(l list.List[*Node[int]]) []int {
+	toSlice := func(l list.List[*Node[int, int64]]) []int {
 		res := make([]int, l.Size())
 		k := 0
 
@@ -168,3 +168,11 @@ func areComponentsEqual(a, b []list.List[*Node[int]]) bool {
 
 	return true
 }
+
+func toNodeList(data ...int) list.List[*Node[int, int64]] {
+	res := list.NewLinkedList[*Node[int, int64]]()
+	for _, e := range data {
+		res.Add(NewNode[int](e))
+	}
+	return res
+}
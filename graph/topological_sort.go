@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/list"
+)
+
+func topologicalOrder[T any, W Weight](g Graph[T, W], operation erx.Operation) (list.List[*Node[T, W]], error) {
+	layers, err := kahn(g, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	res := list.NewArrayList[*Node[T, W]]()
+	for _, layer := range layers {
+		res.AddAll(layer...)
+	}
+
+	return res, nil
+}
+
+func topologicalLayers[T any, W Weight](g Graph[T, W], operation erx.Operation) ([]list.List[*Node[T, W]], error) {
+	layers, err := kahn(g, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]list.List[*Node[T, W]], len(layers))
+	for i, layer := range layers {
+		res[i] = list.NewArrayList[*Node[T, W]](layer...)
+	}
+
+	return res, nil
+}
+
+// kahn sorts the graph one level at a time, every node in a layer only depends
+// on the nodes of the previous layers.
+func kahn[T any, W Weight](g Graph[T, W], operation erx.Operation) ([][]*Node[T, W], error) {
+	nodes := make([]*Node[T, W], 0)
+	inDegrees := make(map[*Node[T, W]]int)
+	predecessors := make(map[*Node[T, W]][]*Node[T, W])
+
+	it := g.Nodes()
+	for it.HasNext() {
+		n, _ := it.Next()
+		nodes = append(nodes, n)
+
+		if _, ok := inDegrees[n]; !ok {
+			inDegrees[n] = 0
+		}
+
+		ei := n.edges.Iterator()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+			inDegrees[e.next]++
+			predecessors[e.next] = append(predecessors[e.next], n)
+		}
+	}
+
+	layer := make([]*Node[T, W], 0)
+	for _, n := range nodes {
+		if inDegrees[n] == 0 {
+			layer = append(layer, n)
+		}
+	}
+
+	res := make([][]*Node[T, W], 0)
+	sorted := 0
+
+	for len(layer) > 0 {
+		res = append(res, layer)
+		sorted += len(layer)
+
+		next := make([]*Node[T, W], 0)
+		for _, n := range layer {
+			ei := n.edges.Iterator()
+			for ei.HasNext() {
+				e, _ := ei.Next()
+
+				inDegrees[e.next]--
+				if inDegrees[e.next] == 0 {
+					next = append(next, e.next)
+				}
+			}
+		}
+
+		layer = next
+	}
+
+	if sorted == len(nodes) {
+		return res, nil
+	}
+
+	for _, n := range nodes {
+		if inDegrees[n] > 0 {
+			return nil, cycleFoundError(dataOf(remainingCycle(n, inDegrees, predecessors)), operation)
+		}
+	}
+
+	return nil, cycleFoundError(nil, operation)
+}
+
+// remainingCycle walks backwards from a node kahn's algorithm could not sort,
+// every such node has at least one unsorted predecessor so the walk ends in a cycle.
+func remainingCycle[T any, W Weight](start *Node[T, W], inDegrees map[*Node[T, W]]int, predecessors map[*Node[T, W]][]*Node[T, W]) []*Node[T, W] {
+	path := make([]*Node[T, W], 0)
+	seen := make(map[*Node[T, W]]int)
+
+	curr := start
+	for {
+		if idx, ok := seen[curr]; ok {
+			res := []*Node[T, W]{path[idx]}
+			for i := len(path) - 1; i > idx; i-- {
+				res = append(res, path[i])
+			}
+			return res
+		}
+
+		seen[curr] = len(path)
+		path = append(path, curr)
+
+		for _, p := range predecessors[curr] {
+			if inDegrees[p] > 0 {
+				curr = p
+				break
+			}
+		}
+	}
+}
+
+func dataOf[T any, W Weight](nodes []*Node[T, W]) []T {
+	res := make([]T, len(nodes))
+
+	for i, n := range nodes {
+		res[i] = n.data
+	}
+
+	return res
+}
@@ -0,0 +1,170 @@
+package graph
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"testing"
+)
+
+func TestListGraphTopologicalSort(t *testing.T) {
+	for _, g := range getGraphs[int](Directed, ACyclic) {
+		res, err := g.TopologicalSort()
+		require.NoError(t, err)
+
+		position := make(map[*Node[int, int64]]int)
+
+		it := res.Iterator()
+		for i := 0; it.HasNext(); i++ {
+			n, _ := it.Next()
+			position[n] = i
+		}
+
+		assert.Equal(t, len(simplifyGraph(g)), len(position))
+
+		ei := g.Edges()
+		for ei.HasNext() {
+			e, _ := ei.Next()
+			assert.Less(t, position[e.From()], position[e.To()])
+		}
+	}
+
+	for _, g := range getGraphs[int](cyclic) {
+		res, err := g.TopologicalSort()
+		assert.Nil(t, res)
+		assert.Error(t, err)
+	}
+}
+
+func TestListGraphTopologicalSortCycleError(t *testing.T) {
+	testCases := map[string]struct {
+		graph         func() Graph[int, int64]
+		expectedError error
+	}{
+		"should name the nodes of the cycle": {
+			graph: func() Graph[int, int64] {
+				g, _ := graphOne()
+				return g
+			},
+			expectedError: errors.New("graph has a cycle through nodes [4 6 5]"),
+		},
+		"should skip the nodes reachable from the cycle": {
+			graph: func() Graph[int, int64] {
+				g := NewListGraph[int]()
+
+				a := NewNode[int](1)
+				b := NewNode[int](2)
+				c := NewNode[int](3)
+				d := NewNode[int](4)
+
+				createEdge(g, false, a, b)
+				createEdge(g, false, b, c)
+				createEdge(g, false, c, b, d)
+
+				return g
+			},
+			expectedError: errors.New("graph has a cycle through nodes [2 3]"),
+		},
+		"should report a self loop": {
+			graph: func() Graph[int, int64] {
+				g := NewListGraph[int]()
+
+				a := NewNode[int](1)
+				createEdge(g, false, a, a)
+
+				return g
+			},
+			expectedError: errors.New("graph has a cycle through nodes [1]"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := testCase.graph().TopologicalSort()
+			internal.AssertErrorEquals(t, testCase.expectedError, err)
+
+			_, err = testCase.graph().TopologicalLayers()
+			internal.AssertErrorEquals(t, testCase.expectedError, err)
+		})
+	}
+}
+
+func TestListGraphTopologicalLayers(t *testing.T) {
+	toData := func(layers []list.List[*Node[int, int64]]) [][]int {
+		res := make([][]int, len(layers))
+
+		for i, layer := range layers {
+			res[i] = make([]int, 0)
+
+			it := layer.Iterator()
+			for it.HasNext() {
+				n, _ := it.Next()
+				res[i] = append(res[i], n.data)
+			}
+
+			sort.Ints(res[i])
+		}
+
+		return res
+	}
+
+	testCases := map[string]struct {
+		graph          func() (Graph[int, int64], propertySet)
+		expectedLayers [][]int
+	}{
+		"should return one node per layer for a chain": {
+			graph:          graphTwenty,
+			expectedLayers: [][]int{{0}, {1}, {2}, {3}},
+		},
+		"should wait for every predecessor before placing a node": {
+			graph:          graphNineTeen,
+			expectedLayers: [][]int{{4}, {6}, {7}, {5}},
+		},
+		"should group independent nodes into the same layer": {
+			graph: func() (Graph[int, int64], propertySet) {
+				g := NewListGraph[int]()
+
+				a := NewNode[int](1)
+				b := NewNode[int](2)
+				c := NewNode[int](3)
+				d := NewNode[int](4)
+				e := NewNode[int](5)
+
+				createEdge(g, false, a, c)
+				createEdge(g, false, b, c)
+				createEdge(g, false, c, d, e)
+
+				return g, newPropertySet()
+			},
+			expectedLayers: [][]int{{1, 2}, {3}, {4, 5}},
+		},
+		"should return every node in the first layer when there are no edges": {
+			graph: func() (Graph[int, int64], propertySet) {
+				g := NewListGraph[int]()
+				g.AddNode(NewNode[int](1))
+				g.AddNode(NewNode[int](2))
+				return g, newPropertySet()
+			},
+			expectedLayers: [][]int{{1, 2}},
+		},
+		"should return no layers for an empty graph": {
+			graph: func() (Graph[int, int64], propertySet) {
+				return NewListGraph[int](), newPropertySet()
+			},
+			expectedLayers: [][]int{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			g, _ := testCase.graph()
+
+			res, err := g.TopologicalLayers()
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedLayers, toData(res))
+		})
+	}
+}
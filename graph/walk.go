@@ -0,0 +1,307 @@
+package graph
+
+import (
+	"context"
+	"github.com/nsnikhil/erx"
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+)
+
+type EdgeKind int
+
+const (
+	// TreeEdge leads to a node seen for the first time.
+	TreeEdge EdgeKind = iota
+	// BackEdge leads to an ancestor of its start in the walk, a loop included.
+	BackEdge
+	// ForwardEdge leads to a descendant already reached through another path, only
+	// depth first walks have them.
+	ForwardEdge
+	// CrossEdge leads to a node that is neither an ancestor nor a descendant.
+	CrossEdge
+)
+
+func (ek EdgeKind) String() string {
+	switch ek {
+	case TreeEdge:
+		return "tree"
+	case BackEdge:
+		return "back"
+	case ForwardEdge:
+		return "forward"
+	case CrossEdge:
+		return "cross"
+	}
+
+	return "unknown"
+}
+
+// Visitor holds the callbacks of a walk, any of them can be nil. Returning false
+// from a callback ends the walk without an error.
+type Visitor[T any, W Weight] struct {
+	// OnDiscover is called when a node is reached, depth is its number of edges from
+	// the root of the walk.
+	OnDiscover func(n *Node[T, W], depth int) bool
+	// OnFinish is called once every edge of a node has been walked.
+	OnFinish func(n *Node[T, W]) bool
+	// OnEdge is called for every edge walked, before the node it leads to is
+	// discovered.
+	OnEdge func(e *Edge[T, W], kind EdgeKind) bool
+}
+
+func (v Visitor[T, W]) discover(n *Node[T, W], depth int) bool {
+	return v.OnDiscover == nil || v.OnDiscover(n, depth)
+}
+
+func (v Visitor[T, W]) finish(n *Node[T, W]) bool {
+	return v.OnFinish == nil || v.OnFinish(n)
+}
+
+func (v Visitor[T, W]) edge(from *Node[T, W], e *edge[T, W], kind EdgeKind) bool {
+	return v.OnEdge == nil || v.OnEdge(e.view(from), kind)
+}
+
+type WalkOptions[T any, W Weight] struct {
+	// Start is the only root of the walk, when nil every node not reached yet
+	// becomes a root in the order of Graph.Nodes.
+	Start *Node[T, W]
+	// MaxDepth stops the walk from following the edges of nodes this many edges
+	// away from the root, zero means no limit. Nodes are walked once, at the depth
+	// they are first reached.
+	MaxDepth int
+}
+
+func (wo WalkOptions[T, W]) roots(g Graph[T, W]) iterator.Iterator[*Node[T, W]] {
+	if wo.Start != nil {
+		return newSingleNodeIterator(wo.Start)
+	}
+
+	return g.Nodes()
+}
+
+func (wo WalkOptions[T, W]) expands(depth int) bool {
+	return wo.MaxDepth <= 0 || depth < wo.MaxDepth
+}
+
+type singleNodeIterator[T any, W Weight] struct {
+	n *Node[T, W]
+}
+
+func newSingleNodeIterator[T any, W Weight](n *Node[T, W]) iterator.Iterator[*Node[T, W]] {
+	return &singleNodeIterator[T, W]{n: n}
+}
+
+func (sni *singleNodeIterator[T, W]) HasNext() bool {
+	return sni.n != nil
+}
+
+func (sni *singleNodeIterator[T, W]) Next() (*Node[T, W], error) {
+	if sni.n == nil {
+		return nil, emptyIteratorError("singleNodeIterator.Next")
+	}
+
+	n := sni.n
+	sni.n = nil
+	return n, nil
+}
+
+func checkWalk[T any, W Weight](ctx context.Context, g Graph[T, W], options WalkOptions[T, W], operation erx.Operation) error {
+	if options.Start != nil && !g.Contains(options.Start) {
+		return nodeNotFoundError(options.Start.data, operation)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return walkCancelledError(err, operation)
+	}
+
+	return nil
+}
+
+// WalkDFS walks g depth first from the roots of options, calling the callbacks of
+// visitor on the way. It stops with an error once ctx is done.
+func WalkDFS[T any, W Weight](ctx context.Context, g Graph[T, W], visitor Visitor[T, W], options WalkOptions[T, W]) error {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return walkDFS(ctx, g, visitor, options, "WalkDFS")
+}
+
+// WalkBFS is WalkDFS walking g breadth first.
+func WalkBFS[T any, W Weight](ctx context.Context, g Graph[T, W], visitor Visitor[T, W], options WalkOptions[T, W]) error {
+	g, unlock := readLocked(g)
+	defer unlock()
+
+	return walkBFS(ctx, g, visitor, options, "WalkBFS")
+}
+
+// dfsFrame is a node on the stack of walkDFS with the edges it has left to walk.
+type dfsFrame[T any, W Weight] struct {
+	node  *Node[T, W]
+	edges iterator.Iterator[*edge[T, W]]
+	depth int
+}
+
+// walkDFS keeps its own stack instead of recursing so that long paths in large
+// graphs cannot overflow the goroutine stack. Edges are told apart by the
+// discovery order and whether the node they lead to is still on the stack.
+func walkDFS[T any, W Weight](ctx context.Context, g Graph[T, W], visitor Visitor[T, W], options WalkOptions[T, W], operation erx.Operation) error {
+	if err := checkWalk(ctx, g, options, operation); err != nil {
+		return err
+	}
+
+	discovery := make(map[*Node[T, W]]int)
+	finished := make(map[*Node[T, W]]bool)
+
+	roots := options.roots(g)
+	for roots.HasNext() {
+		root, _ := roots.Next()
+		if _, ok := discovery[root]; ok {
+			continue
+		}
+
+		discovery[root] = len(discovery)
+		if !visitor.discover(root, 0) {
+			return nil
+		}
+
+		stack := []*dfsFrame[T, W]{{node: root, edges: root.edges.Iterator()}}
+
+		for len(stack) > 0 {
+			if err := ctx.Err(); err != nil {
+				return walkCancelledError(err, operation)
+			}
+
+			top := stack[len(stack)-1]
+
+			if !options.expands(top.depth) || !top.edges.HasNext() {
+				stack = stack[:len(stack)-1]
+				finished[top.node] = true
+
+				if !visitor.finish(top.node) {
+					return nil
+				}
+
+				continue
+			}
+
+			e, _ := top.edges.Next()
+			nx := e.next
+
+			d, seen := discovery[nx]
+
+			switch {
+			case !seen:
+				if !visitor.edge(top.node, e, TreeEdge) {
+					return nil
+				}
+
+				discovery[nx] = len(discovery)
+				if !visitor.discover(nx, top.depth+1) {
+					return nil
+				}
+
+				stack = append(stack, &dfsFrame[T, W]{node: nx, edges: nx.edges.Iterator(), depth: top.depth + 1})
+			case !finished[nx]:
+				if !visitor.edge(top.node, e, BackEdge) {
+					return nil
+				}
+			case discovery[top.node] < d:
+				if !visitor.edge(top.node, e, ForwardEdge) {
+					return nil
+				}
+			default:
+				if !visitor.edge(top.node, e, CrossEdge) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// walkBFS finishes a node as soon as all its edges are walked, an edge to a node
+// already seen is a back edge if that node is on the path from the root.
+func walkBFS[T any, W Weight](ctx context.Context, g Graph[T, W], visitor Visitor[T, W], options WalkOptions[T, W], operation erx.Operation) error {
+	if err := checkWalk(ctx, g, options, operation); err != nil {
+		return err
+	}
+
+	depths := make(map[*Node[T, W]]int)
+	parents := make(map[*Node[T, W]]*Node[T, W])
+
+	isAncestor := func(a, n *Node[T, W]) bool {
+		for ; n != nil && depths[n] >= depths[a]; n = parents[n] {
+			if n == a {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	roots := options.roots(g)
+	for roots.HasNext() {
+		root, _ := roots.Next()
+		if _, ok := depths[root]; ok {
+			continue
+		}
+
+		depths[root] = 0
+		parents[root] = nil
+
+		if !visitor.discover(root, 0) {
+			return nil
+		}
+
+		q := []*Node[T, W]{root}
+
+		for len(q) > 0 {
+			if err := ctx.Err(); err != nil {
+				return walkCancelledError(err, operation)
+			}
+
+			curr := q[0]
+			q = q[1:]
+
+			if options.expands(depths[curr]) {
+				it := curr.edges.Iterator()
+				for it.HasNext() {
+					e, _ := it.Next()
+					nx := e.next
+
+					if _, seen := depths[nx]; seen {
+						kind := CrossEdge
+						if isAncestor(nx, curr) {
+							kind = BackEdge
+						}
+
+						if !visitor.edge(curr, e, kind) {
+							return nil
+						}
+
+						continue
+					}
+
+					if !visitor.edge(curr, e, TreeEdge) {
+						return nil
+					}
+
+					depths[nx] = depths[curr] + 1
+					parents[nx] = curr
+
+					if !visitor.discover(nx, depths[nx]) {
+						return nil
+					}
+
+					q = append(q, nx)
+				}
+			}
+
+			if !visitor.finish(curr) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
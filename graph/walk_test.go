@@ -0,0 +1,195 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type walkRecord struct {
+	discovered []int
+	depths     []int
+	finished   []int
+	kinds      []EdgeKind
+}
+
+func (wr *walkRecord) visitor() Visitor[int, int64] {
+	return Visitor[int, int64]{
+		OnDiscover: func(n *Node[int, int64], depth int) bool {
+			wr.discovered = append(wr.discovered, n.Data())
+			wr.depths = append(wr.depths, depth)
+			return true
+		},
+		OnFinish: func(n *Node[int, int64]) bool {
+			wr.finished = append(wr.finished, n.Data())
+			return true
+		},
+		OnEdge: func(e *Edge[int, int64], kind EdgeKind) bool {
+			wr.kinds = append(wr.kinds, kind)
+			return true
+		},
+	}
+}
+
+func chainGraph(g Graph[int, int64], size int, closed bool) []*Node[int, int64] {
+	nodes := make([]*Node[int, int64], size)
+	for i := range nodes {
+		nodes[i] = NewNode[int](i)
+		g.AddNode(nodes[i])
+	}
+
+	for i := 0; i+1 < size; i++ {
+		createEdge(g, false, nodes[i], nodes[i+1])
+	}
+
+	if closed {
+		createEdge(g, false, nodes[size-1], nodes[0])
+	}
+
+	return nodes
+}
+
+var walks = map[string]func(context.Context, Graph[int, int64], Visitor[int, int64], WalkOptions[int, int64]) error{
+	"dfs": WalkDFS[int, int64],
+	"bfs": WalkBFS[int, int64],
+}
+
+func countKind(kinds []EdgeKind, kind EdgeKind) int {
+	res := 0
+	for _, k := range kinds {
+		if k == kind {
+			res++
+		}
+	}
+
+	return res
+}
+
+func TestWalk(t *testing.T) {
+	forEachGraphKind(t, testWalk)
+}
+
+func testWalk(t *testing.T, newGraph func() Graph[int, int64]) {
+	for name, walk := range walks {
+		t.Run(name, func(t *testing.T) {
+			g := newGraph()
+			nodes := chainGraph(g, 3, true)
+
+			wr := &walkRecord{}
+			require.NoError(t, walk(context.Background(), g, wr.visitor(), WalkOptions[int, int64]{Start: nodes[0]}))
+			assert.Equal(t, []int{0, 1, 2}, wr.discovered)
+			assert.Equal(t, []int{0, 1, 2}, wr.depths)
+			assert.Equal(t, []EdgeKind{TreeEdge, TreeEdge, BackEdge}, wr.kinds)
+			assert.ElementsMatch(t, []int{0, 1, 2}, wr.finished)
+
+			g = newGraph()
+			nodes = chainGraph(g, 5, false)
+
+			wr = &walkRecord{}
+			require.NoError(t, walk(context.Background(), g, wr.visitor(), WalkOptions[int, int64]{Start: nodes[1], MaxDepth: 2}))
+			assert.Equal(t, []int{1, 2, 3}, wr.discovered)
+			assert.Equal(t, []int{0, 1, 2}, wr.depths)
+
+			wr = &walkRecord{}
+			v := wr.visitor()
+			v.OnDiscover = func(n *Node[int, int64], depth int) bool {
+				wr.discovered = append(wr.discovered, n.Data())
+				return n.Data() != 2
+			}
+
+			require.NoError(t, walk(context.Background(), g, v, WalkOptions[int, int64]{}))
+			assert.Equal(t, []int{0, 1, 2}, wr.discovered)
+
+			ctx, cancel := context.WithCancel(context.Background())
+
+			wr = &walkRecord{}
+			v = wr.visitor()
+			v.OnDiscover = func(n *Node[int, int64], depth int) bool {
+				wr.discovered = append(wr.discovered, n.Data())
+				if n.Data() == 1 {
+					cancel()
+				}
+				return true
+			}
+
+			err := walk(ctx, g, v, WalkOptions[int, int64]{Start: nodes[0]})
+			internal.AssertErrorEquals(t, errors.New("walk cancelled: context canceled"), err)
+			assert.Equal(t, []int{0, 1}, wr.discovered)
+
+			err = walk(ctx, g, Visitor[int, int64]{}, WalkOptions[int, int64]{})
+			internal.AssertErrorEquals(t, errors.New("walk cancelled: context canceled"), err)
+
+			err = walk(context.Background(), g, Visitor[int, int64]{}, WalkOptions[int, int64]{Start: NewNode[int](9)})
+			internal.AssertErrorEquals(t, errors.New("node 9 not found in the graph"), err)
+
+			// the second edge from 0 to 1 has no label and is walked once 1 is
+			// discovered, so only two of the three edges are tree edges.
+			g = newGraph()
+			nodes = chainGraph(g, 3, false)
+			createWeightedEdge(g, false, nodes[0], nodes[1], 3)
+
+			wr = &walkRecord{}
+			require.NoError(t, walk(context.Background(), g, wr.visitor(), WalkOptions[int, int64]{Start: nodes[0]}))
+			assert.Equal(t, []int{0, 1, 2}, wr.discovered)
+			assert.Equal(t, 3, len(wr.kinds))
+			assert.Equal(t, 2, countKind(wr.kinds, TreeEdge))
+		})
+	}
+
+	// whichever of a > 1 and a > 2 is walked first decides if 1 > 2 is a tree edge
+	// with a forward edge after it or a cross edge to an already finished node.
+	g := newGraph()
+	nodes := chainGraph(g, 3, false)
+	createEdge(g, false, nodes[0], nodes[2])
+
+	wr := &walkRecord{}
+	require.NoError(t, WalkDFS(context.Background(), g, wr.visitor(), WalkOptions[int, int64]{Start: nodes[0]}))
+	require.Equal(t, 3, len(wr.kinds))
+	assert.Contains(t, [][]EdgeKind{{TreeEdge, TreeEdge, ForwardEdge}, {TreeEdge, TreeEdge, CrossEdge}}, wr.kinds)
+
+	wr = &walkRecord{}
+	require.NoError(t, WalkBFS(context.Background(), g, wr.visitor(), WalkOptions[int, int64]{Start: nodes[0]}))
+	assert.Equal(t, []EdgeKind{TreeEdge, TreeEdge, CrossEdge}, wr.kinds)
+	assert.Equal(t, []int{0, 1, 1}, wr.depths)
+}
+
+func TestWalkOnAllGraphs(t *testing.T) {
+	graphs := getAllGraphs()
+	for _, g := range getAllGraphs() {
+		graphs = append(graphs, toMatrixGraph(g))
+	}
+
+	for name, walk := range walks {
+		t.Run(name, func(t *testing.T) {
+			for i, g := range graphs {
+				wr := &walkRecord{}
+				require.NoError(t, walk(context.Background(), g, wr.visitor(), WalkOptions[int, int64]{}))
+
+				data := nodeData(g)
+				assert.ElementsMatch(t, data, wr.discovered, "graph %d", i)
+				assert.ElementsMatch(t, data, wr.finished, "graph %d", i)
+				assert.Equal(t, len(edgeList(g)), len(wr.kinds), "graph %d", i)
+
+				roots := 0
+				for _, d := range wr.depths {
+					if d == 0 {
+						roots++
+					}
+				}
+
+				assert.Equal(t, len(data)-roots, countKind(wr.kinds, TreeEdge), "graph %d", i)
+			}
+		})
+	}
+}
+
+func TestEdgeKindString(t *testing.T) {
+	assert.Equal(t, "tree", TreeEdge.String())
+	assert.Equal(t, "back", BackEdge.String())
+	assert.Equal(t, "forward", ForwardEdge.String())
+	assert.Equal(t, "cross", CrossEdge.String())
+	assert.Equal(t, "unknown", EdgeKind(7).String())
+}
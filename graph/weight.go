@@ -0,0 +1,7 @@
+package graph
+
+// Weight is the constraint on the type of the edge weights, the constructors
+// without an explicit weight type build graphs weighted by int64.
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
@@ -8,6 +8,7 @@ import (
 	"golang.org/x/crypto/sha3"
 	"hash"
 	"reflect"
+	"sync"
 )
 
 type factors struct {
@@ -24,9 +25,11 @@ type counter struct {
 	uniqueCount  int64
 }
 
-type HashMap[K comparable, V comparable] struct {
-	h hash.Hash
+// hashers holds the hashers lookups borrow, a map owns none of them so that
+// reads can run concurrently.
+var hashers = sync.Pool{New: func() interface{} { return sha3.New512() }}
 
+type HashMap[K comparable, V comparable] struct {
 	*factors
 	*counter
 
@@ -188,7 +191,6 @@ func (hm *HashMap[K, V]) Values() (list.List[V], error) {
 }
 
 func (hm *HashMap[K, V]) Clear() {
-	hm.h = sha3.New512()
 	hm.capacity = initialCapacity
 	hm.elementCount = internal.Zero
 	hm.uniqueCount = internal.Zero
@@ -260,7 +262,7 @@ func (hm *HashMap[K, V]) insert(p *Pair[K, V]) error {
 		hm.resizeUp()
 	}
 
-	idx, err := indexOf(&hm.h, p.first, hm.capacity)
+	idx, err := hm.indexOf(p.first)
 	if err != nil {
 		return err
 	}
@@ -307,7 +309,7 @@ func (hm *HashMap[K, V]) insert(p *Pair[K, V]) error {
 }
 
 func (hm *HashMap[K, V]) remove(key K) error {
-	idx, err := indexOf(&hm.h, key, hm.capacity)
+	idx, err := hm.indexOf(key)
 	if err != nil {
 		return err
 	}
@@ -352,8 +354,15 @@ func (hm *HashMap[K, V]) remove(key K) error {
 	return nil
 }
 
+func (hm *HashMap[K, V]) indexOf(key K) (int64, error) {
+	h := hashers.Get().(hash.Hash)
+	defer hashers.Put(h)
+
+	return indexOf(&h, key, hm.capacity)
+}
+
 func (hm *HashMap[K, V]) get(key K) (*Pair[K, V], error) {
-	idx, err := indexOf(&hm.h, key, hm.capacity)
+	idx, err := hm.indexOf(key)
 	if err != nil {
 		return nil, err
 	}
@@ -434,7 +443,6 @@ func newHashMap[K comparable, V comparable]() *HashMap[K, V] {
 	return &HashMap[K, V]{
 		factors: &factors{upperLoadFactor: upperLoadFactor, lowerLoadFactor: lowerLoadFactor, scalingFactor: scalingFactor, capacity: initialCapacity},
 		counter: &counter{elementCount: internal.Zero, countMap: make(map[int64]bool), uniqueCount: internal.Zero},
-		h:       sha3.New512(),
 		data:    make([]*list.LinkedList[*Pair[K, V]], initialCapacity),
 	}
 }
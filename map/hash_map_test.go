@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/sha3"
 	"strconv"
+	"sync"
 	"testing"
 )
 
@@ -29,7 +30,6 @@ func TestCreateNewHashMap(t *testing.T) {
 					factors: &factors{capacity: 16, upperLoadFactor: 0.75, lowerLoadFactor: 0.40, scalingFactor: 2},
 					counter: &counter{elementCount: 0, countMap: make(map[int64]bool), uniqueCount: 0},
 					data:    make([]*list.LinkedList[*Pair[int64, int64]], 16),
-					h:       sha3.New512(),
 				}
 			},
 		},
@@ -70,7 +70,6 @@ func TestCreateNewHashMap(t *testing.T) {
 				return &HashMap[int64, int64]{
 					factors: &factors{capacity: 16, upperLoadFactor: 0.75, lowerLoadFactor: 0.40, scalingFactor: 2},
 					counter: &counter{elementCount: 10, countMap: cp, uniqueCount: 9},
-					h:       hs,
 					data:    nwl,
 				}
 
@@ -133,7 +132,6 @@ func TestCreateNewHashMap(t *testing.T) {
 				return &HashMap[int64, int64]{
 					factors: &factors{capacity: 32, upperLoadFactor: 0.75, lowerLoadFactor: 0.40, scalingFactor: 2},
 					counter: &counter{elementCount: 24, countMap: cp, uniqueCount: 18},
-					h:       hs,
 					data:    nwl,
 				}
 			},
@@ -583,7 +581,6 @@ func TestHashMapRemoveWithVal(t *testing.T) {
 				return &HashMap[int, rune]{
 					factors: &factors{capacity: 16, upperLoadFactor: 0.75, lowerLoadFactor: 0.40, scalingFactor: 2},
 					counter: &counter{elementCount: 1, countMap: map[int64]bool{0: true}, uniqueCount: 1},
-					h:       hs,
 					data:    d,
 				}
 			},
@@ -1258,6 +1255,29 @@ func TestHashMapIsEmpty(t *testing.T) {
 	}
 }
 
+func TestHashMapConcurrentReads(t *testing.T) {
+	hm := NewHashMap[int64, int64](sliceToPair(internal.SliceGenerator{Size: 100}.Generate())...)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := int64(0); i < 100; i++ {
+				v, err := hm.Get(i)
+				assert.NoError(t, err)
+				assert.Equal(t, i, v)
+				assert.True(t, hm.ContainsKey(i))
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
 func sliceToPair(data []int64) []*Pair[int64, int64] {
 	sz := len(data)
 	res := make([]*Pair[int64, int64], sz)
@@ -0,0 +1,189 @@
+package set
+
+import (
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/nsnikhil/go-datastructures/list"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+)
+
+const maxPowerSetSize = 20
+
+func Union[T comparable](a, b Set[T]) Set[T] {
+	res := emptyLike(a)
+
+	addAll(res, a)
+	addAll(res, b)
+
+	return res
+}
+
+func Intersection[T comparable](a, b Set[T]) Set[T] {
+	res := emptyLike(a)
+
+	// hash sets have no order to preserve, so probe the larger set with the smaller one.
+	small, large := a, b
+	if _, ok := a.(*HashSet[T]); ok && b.Size() < a.Size() {
+		small, large = b, a
+	}
+
+	it := small.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		if large.Contains(e) {
+			res.Add(e)
+		}
+	}
+
+	return res
+}
+
+func Difference[T comparable](a, b Set[T]) Set[T] {
+	res := emptyLike(a)
+
+	it := a.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		if !b.Contains(e) {
+			res.Add(e)
+		}
+	}
+
+	return res
+}
+
+func SymmetricDifference[T comparable](a, b Set[T]) Set[T] {
+	res := Difference(a, b)
+
+	it := b.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		if !a.Contains(e) {
+			res.Add(e)
+		}
+	}
+
+	return res
+}
+
+func IsSubsetOf[T comparable](a, b Set[T]) bool {
+	if a.Size() > b.Size() {
+		return false
+	}
+
+	return containsEvery(b, a)
+}
+
+func IsSupersetOf[T comparable](a, b Set[T]) bool {
+	return IsSubsetOf(b, a)
+}
+
+func IsDisjoint[T comparable](a, b Set[T]) bool {
+	small, large := a, b
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+
+	it := small.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		if large.Contains(e) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func Equals[T comparable](a, b Set[T]) bool {
+	return a.Size() == b.Size() && containsEvery(a, b)
+}
+
+// PowerSet returns every subset of s, the result grows as 2^n so sets larger
+// than maxPowerSetSize elements are rejected.
+func PowerSet[T comparable](s Set[T]) ([]Set[T], error) {
+	if s.Size() > maxPowerSetSize {
+		return nil, setTooLargeError(s.Size(), "PowerSet")
+	}
+
+	elements := toSlice(s)
+
+	res := make([]Set[T], 0, 1<<len(elements))
+
+	for mask := 0; mask < 1<<len(elements); mask++ {
+		ss := emptyLike(s)
+
+		for i, e := range elements {
+			if mask&(1<<i) != internal.Zero {
+				ss.Add(e)
+			}
+		}
+
+		res = append(res, ss)
+	}
+
+	return res, nil
+}
+
+func CartesianProduct[A comparable, B comparable](a Set[A], b Set[B]) list.List[*gmap.Pair[A, B]] {
+	res := list.NewArrayList[*gmap.Pair[A, B]]()
+
+	bs := toSlice(b)
+
+	it := a.Iterator()
+	for it.HasNext() {
+		x, _ := it.Next()
+
+		for _, y := range bs {
+			res.Add(gmap.NewPair[A, B](x, y))
+		}
+	}
+
+	return res
+}
+
+// emptyLike returns an empty set to build the result of an operation on s. A hash
+// set gets a hash set and any other set a linked hash set, which keeps the order
+// s iterates its elements in, like the insertion order of a linked hash set or
+// the ascending order of a bitset view.
+func emptyLike[T comparable](s Set[T]) Set[T] {
+	if _, ok := s.(*HashSet[T]); ok {
+		return NewHashSet[T]()
+	}
+
+	return NewLinkedHashSet[T]()
+}
+
+func addAll[T comparable](dst, src Set[T]) {
+	it := src.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		dst.Add(e)
+	}
+}
+
+// containsEvery has no HashSet shortcut, walking the map behind a HashSet and
+// probing the one of another costs the same as its Iterator and Contains, so the
+// only saving left for hash sets is probing the larger set, see IsDisjoint.
+func containsEvery[T comparable](s, of Set[T]) bool {
+	it := of.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		if !s.Contains(e) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toSlice[T comparable](s Set[T]) []T {
+	res := make([]T, 0, s.Size())
+
+	it := s.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		res = append(res, e)
+	}
+
+	return res
+}
@@ -0,0 +1,176 @@
+package set
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"testing"
+)
+
+func sortedElements(s Set[int]) []int {
+	res := toSlice(s)
+	sort.Ints(res)
+	return res
+}
+
+func TestSetAlgebraOperations(t *testing.T) {
+	testCases := map[string]struct {
+		actualResult   func() Set[int]
+		expectedResult []int
+	}{
+		"union of two sets": {
+			actualResult:   func() Set[int] { return Union[int](NewHashSet(1, 2, 3), NewHashSet(3, 4)) },
+			expectedResult: []int{1, 2, 3, 4},
+		},
+		"intersection of two sets": {
+			actualResult:   func() Set[int] { return Intersection[int](NewHashSet(1, 2, 3, 4, 5), NewHashSet(3, 4, 9)) },
+			expectedResult: []int{3, 4},
+		},
+		"intersection with empty set": {
+			actualResult:   func() Set[int] { return Intersection[int](NewHashSet(1, 2), NewHashSet[int]()) },
+			expectedResult: []int{},
+		},
+		"difference of two sets": {
+			actualResult:   func() Set[int] { return Difference[int](NewHashSet(1, 2, 3, 4), NewHashSet(3, 4, 5)) },
+			expectedResult: []int{1, 2},
+		},
+		"difference with itself": {
+			actualResult: func() Set[int] {
+				s := NewHashSet(1, 2, 3)
+				return Difference[int](s, s)
+			},
+			expectedResult: []int{},
+		},
+		"symmetric difference of two sets": {
+			actualResult:   func() Set[int] { return SymmetricDifference[int](NewHashSet(1, 2, 3, 4), NewHashSet(3, 4, 5)) },
+			expectedResult: []int{1, 2, 5},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedResult, sortedElements(testCase.actualResult()))
+		})
+	}
+}
+
+func TestSetAlgebraDoesNotMutateArguments(t *testing.T) {
+	a := NewHashSet(1, 2, 3)
+	b := NewHashSet(3, 4)
+
+	_ = Union[int](a, b)
+	_ = Intersection[int](a, b)
+	_ = Difference[int](a, b)
+	_ = SymmetricDifference[int](a, b)
+
+	assert.Equal(t, []int{1, 2, 3}, sortedElements(a))
+	assert.Equal(t, []int{3, 4}, sortedElements(b))
+}
+
+func TestSetAlgebraPredicates(t *testing.T) {
+	testCases := map[string]struct {
+		actualResult   func() bool
+		expectedResult bool
+	}{
+		"subset when all elements are present": {
+			actualResult:   func() bool { return IsSubsetOf[int](NewHashSet(1, 2), NewHashSet(1, 2, 3)) },
+			expectedResult: true,
+		},
+		"empty set is subset of any set": {
+			actualResult:   func() bool { return IsSubsetOf[int](NewHashSet[int](), NewHashSet(1)) },
+			expectedResult: true,
+		},
+		"not subset when an element is missing": {
+			actualResult:   func() bool { return IsSubsetOf[int](NewHashSet(1, 4), NewHashSet(1, 2, 3)) },
+			expectedResult: false,
+		},
+		"not subset when larger": {
+			actualResult:   func() bool { return IsSubsetOf[int](NewHashSet(1, 2, 3), NewHashSet(1, 2)) },
+			expectedResult: false,
+		},
+		"superset when all elements of other are present": {
+			actualResult:   func() bool { return IsSupersetOf[int](NewHashSet(1, 2, 3), NewHashSet(3)) },
+			expectedResult: true,
+		},
+		"disjoint when nothing is common": {
+			actualResult:   func() bool { return IsDisjoint[int](NewHashSet(1, 2), NewHashSet(3, 4, 5)) },
+			expectedResult: true,
+		},
+		"not disjoint when an element is common": {
+			actualResult:   func() bool { return IsDisjoint[int](NewHashSet(1, 2, 5), NewHashSet(3, 4, 5)) },
+			expectedResult: false,
+		},
+		"equal when elements are same": {
+			actualResult:   func() bool { return Equals[int](NewHashSet(3, 2, 1), NewHashSet(1, 2, 3)) },
+			expectedResult: true,
+		},
+		"not equal when elements differ": {
+			actualResult:   func() bool { return Equals[int](NewHashSet(1, 2, 4), NewHashSet(1, 2, 3)) },
+			expectedResult: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedResult, testCase.actualResult())
+		})
+	}
+}
+
+func TestSetAlgebraKeepsKindOfFirstSet(t *testing.T) {
+	a := NewLinkedHashSet(3, 1, 2)
+	b := NewHashSet(5, 1, 4)
+
+	union := Union[int](a, b)
+	require.IsType(t, a, union)
+	assert.Equal(t, []int{3, 1, 2}, toSlice(union)[:3])
+
+	assert.Equal(t, []int{3, 2}, toSlice(Difference[int](a, b)))
+	assert.IsType(t, b, Intersection[int](b, a))
+
+	ps, err := PowerSet[int](a)
+	require.NoError(t, err)
+
+	for _, s := range ps {
+		assert.IsType(t, a, s)
+	}
+
+	assert.Equal(t, []int{3, 1, 2}, toSlice(ps[len(ps)-1]))
+}
+
+func TestSetPowerSet(t *testing.T) {
+	ps, err := PowerSet[int](NewHashSet(1, 2, 3))
+	require.NoError(t, err)
+
+	res := make([][]int, 0)
+	for _, s := range ps {
+		res = append(res, sortedElements(s))
+	}
+
+	assert.ElementsMatch(t, [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}, res)
+
+	large := NewHashSet[int]()
+	for i := 0; i <= maxPowerSetSize; i++ {
+		large.Add(i)
+	}
+
+	_, err = PowerSet[int](large)
+	internal.AssertErrorEquals(t, errors.New("set of size 21 is too large"), err)
+}
+
+func TestSetCartesianProduct(t *testing.T) {
+	res := CartesianProduct[int, string](NewHashSet(1, 2), NewHashSet("a", "b", "c"))
+
+	pairs := make([]string, 0)
+
+	it := res.Iterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		pairs = append(pairs, string(rune('0'+p.First()))+p.Second())
+	}
+
+	assert.ElementsMatch(t, []string{"1a", "1b", "1c", "2a", "2b", "2c"}, pairs)
+	assert.True(t, CartesianProduct[int, string](NewHashSet[int](), NewHashSet("a")).IsEmpty())
+}
@@ -0,0 +1,294 @@
+package set
+
+import (
+	"github.com/nsnikhil/go-datastructures/functions/comparator"
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	"github.com/nsnikhil/go-datastructures/internal"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+	"github.com/nsnikhil/go-datastructures/tree"
+)
+
+type multiset[T comparable] struct {
+	size   int64
+	counts gmap.Map[T, int64]
+
+	// only set for the sorted variant, keeps the distinct elements in comparator order.
+	c     comparator.Comparator[T]
+	order *tree.BinarySearchTree[T]
+}
+
+type HashMultiset[T comparable] struct {
+	*multiset[T]
+}
+
+func NewHashMultiset[T comparable](e ...T) *HashMultiset[T] {
+	hm := &HashMultiset[T]{multiset: newMultiset[T](nil)}
+
+	hm.insert(e...)
+
+	return hm
+}
+
+func (ms *multiset[T]) Add(e T, n int64) error {
+	if n < internal.Zero {
+		return invalidCountError(n, "Multiset.Add")
+	}
+
+	if n == internal.Zero {
+		return nil
+	}
+
+	ms.setCount(e, ms.Count(e)+n)
+
+	return nil
+}
+
+func (ms *multiset[T]) Count(e T) int64 {
+	return ms.counts.GetOrDefault(e, internal.Zero)
+}
+
+func (ms *multiset[T]) Remove(e T, n int64) (int64, error) {
+	if n < internal.Zero {
+		return internal.Zero, invalidCountError(n, "Multiset.Remove")
+	}
+
+	old := ms.Count(e)
+	if old == internal.Zero {
+		return internal.Zero, elementNotFoundError(e, "Multiset.Remove")
+	}
+
+	if n > old {
+		n = old
+	}
+
+	ms.setCount(e, old-n)
+
+	return old, nil
+}
+
+func (ms *multiset[T]) SetCount(e T, n int64) (int64, error) {
+	if n < internal.Zero {
+		return internal.Zero, invalidCountError(n, "Multiset.SetCount")
+	}
+
+	old := ms.Count(e)
+
+	ms.setCount(e, n)
+
+	return old, nil
+}
+
+func (ms *multiset[T]) Contains(e T) bool {
+	return ms.counts.ContainsKey(e)
+}
+
+func (ms *multiset[T]) Clear() {
+	ms.counts.Clear()
+	ms.size = internal.Zero
+
+	if ms.order != nil {
+		ms.order.Clear()
+	}
+}
+
+func (ms *multiset[T]) Copy() Multiset[T] {
+	res := ms.empty()
+
+	it := ms.EntryIterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		res.setCount(p.First(), p.Second())
+	}
+
+	return res.wrap()
+}
+
+func (ms *multiset[T]) IsEmpty() bool {
+	return ms.size == internal.Zero
+}
+
+func (ms *multiset[T]) Size() int64 {
+	return ms.size
+}
+
+func (ms *multiset[T]) ElementSet() Set[T] {
+	res := NewHashSet[T]()
+
+	it := ms.EntryIterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		res.Add(p.First())
+	}
+
+	return res
+}
+
+func (ms *multiset[T]) EntryIterator() iterator.Iterator[*gmap.Pair[T, int64]] {
+	if ms.order != nil {
+		return &sortedEntryIterator[T]{it: ms.order.InOrderIterator(), counts: ms.counts}
+	}
+
+	return ms.counts.Iterator()
+}
+
+func (ms *multiset[T]) Iterator() iterator.Iterator[T] {
+	return &multisetIterator[T]{it: ms.EntryIterator()}
+}
+
+func (ms *multiset[T]) Union(m Multiset[T]) Multiset[T] {
+	return ms.combine(m, func(a, b int64) int64 {
+		if a > b {
+			return a
+		}
+
+		return b
+	})
+}
+
+func (ms *multiset[T]) Intersection(m Multiset[T]) Multiset[T] {
+	return ms.combine(m, func(a, b int64) int64 {
+		if a < b {
+			return a
+		}
+
+		return b
+	})
+}
+
+func (ms *multiset[T]) Difference(m Multiset[T]) Multiset[T] {
+	return ms.combine(m, func(a, b int64) int64 {
+		if a < b {
+			return internal.Zero
+		}
+
+		return a - b
+	})
+}
+
+func (ms *multiset[T]) Sum(m Multiset[T]) Multiset[T] {
+	return ms.combine(m, func(a, b int64) int64 {
+		return a + b
+	})
+}
+
+func (ms *multiset[T]) combine(m Multiset[T], f func(a, b int64) int64) Multiset[T] {
+	res := ms.empty()
+
+	it := ms.EntryIterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		res.setCount(p.First(), f(p.Second(), m.Count(p.First())))
+	}
+
+	it = m.EntryIterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		if !ms.Contains(p.First()) {
+			res.setCount(p.First(), f(internal.Zero, p.Second()))
+		}
+	}
+
+	return res.wrap()
+}
+
+func (ms *multiset[T]) insert(e ...T) {
+	for _, k := range e {
+		ms.setCount(k, ms.Count(k)+1)
+	}
+}
+
+func (ms *multiset[T]) setCount(e T, n int64) {
+	old := ms.Count(e)
+
+	if n == internal.Zero {
+		if old != internal.Zero {
+			_, _ = ms.counts.Remove(e)
+
+			if ms.order != nil {
+				_ = ms.order.Delete(e)
+			}
+		}
+	} else {
+		ms.counts.Put(e, n)
+
+		if old == internal.Zero && ms.order != nil {
+			ms.order.Insert(e)
+		}
+	}
+
+	ms.size += n - old
+}
+
+func (ms *multiset[T]) empty() *multiset[T] {
+	return newMultiset[T](ms.c)
+}
+
+func (ms *multiset[T]) wrap() Multiset[T] {
+	if ms.c != nil {
+		return &TreeMultiset[T]{multiset: ms}
+	}
+
+	return &HashMultiset[T]{multiset: ms}
+}
+
+type multisetIterator[T comparable] struct {
+	it        iterator.Iterator[*gmap.Pair[T, int64]]
+	curr      T
+	remaining int64
+}
+
+func (mi *multisetIterator[T]) HasNext() bool {
+	return mi.remaining > internal.Zero || mi.it.HasNext()
+}
+
+func (mi *multisetIterator[T]) Next() (T, error) {
+	if mi.remaining == internal.Zero {
+		if !mi.it.HasNext() {
+			return internal.ZeroValueOf[T](), emptyIteratorError("multisetIterator.Next")
+		}
+
+		p, err := mi.it.Next()
+		if err != nil {
+			return internal.ZeroValueOf[T](), err
+		}
+
+		mi.curr = p.First()
+		mi.remaining = p.Second()
+	}
+
+	mi.remaining--
+
+	return mi.curr, nil
+}
+
+type sortedEntryIterator[T comparable] struct {
+	it     iterator.Iterator[T]
+	counts gmap.Map[T, int64]
+}
+
+func (sei *sortedEntryIterator[T]) HasNext() bool {
+	return sei.it.HasNext()
+}
+
+func (sei *sortedEntryIterator[T]) Next() (*gmap.Pair[T, int64], error) {
+	e, err := sei.it.Next()
+	if err != nil {
+		return nil, emptyIteratorError("sortedEntryIterator.Next")
+	}
+
+	return gmap.NewPair[T, int64](e, sei.counts.GetOrDefault(e, internal.Zero)), nil
+}
+
+func newMultiset[T comparable](c comparator.Comparator[T]) *multiset[T] {
+	ms := &multiset[T]{
+		size:   internal.Zero,
+		counts: gmap.NewHashMap[T, int64](),
+		c:      c,
+	}
+
+	if c != nil {
+		ms.order = tree.NewBinarySearchTree[T](c)
+	}
+
+	return ms
+}
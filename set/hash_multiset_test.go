@@ -0,0 +1,193 @@
+package set
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"testing"
+)
+
+func multisetToMap[T comparable](m Multiset[T]) map[T]int64 {
+	res := make(map[T]int64)
+
+	it := m.EntryIterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		res[p.First()] = p.Second()
+	}
+
+	return res
+}
+
+func TestCreateNewHashMultiset(t *testing.T) {
+	m := NewHashMultiset[int](1, 2, 2, 3, 3, 3)
+
+	assert.Equal(t, int64(6), m.Size())
+	assert.Equal(t, map[int]int64{1: 1, 2: 2, 3: 3}, multisetToMap[int](m))
+	assert.True(t, NewHashMultiset[int]().IsEmpty())
+}
+
+func TestHashMultisetAdd(t *testing.T) {
+	m := NewHashMultiset[string]()
+
+	require.NoError(t, m.Add("a", 2))
+	require.NoError(t, m.Add("a", 3))
+	require.NoError(t, m.Add("b", 0))
+
+	assert.Equal(t, int64(5), m.Count("a"))
+	assert.False(t, m.Contains("b"))
+	assert.Equal(t, int64(5), m.Size())
+
+	internal.AssertErrorEquals(t, errors.New("invalid count -1"), m.Add("a", -1))
+}
+
+func TestHashMultisetRemove(t *testing.T) {
+	testCases := map[string]struct {
+		actualResult   func() (int64, error, Multiset[string])
+		expectedResult map[string]int64
+		expectedOld    int64
+		expectedError  error
+	}{
+		"should remove some occurrences": {
+			actualResult: func() (int64, error, Multiset[string]) {
+				m := NewHashMultiset[string]("a", "a", "a", "b")
+				old, err := m.Remove("a", 2)
+				return old, err, m
+			},
+			expectedResult: map[string]int64{"a": 1, "b": 1},
+			expectedOld:    3,
+		},
+		"should remove element when more occurrences are removed than present": {
+			actualResult: func() (int64, error, Multiset[string]) {
+				m := NewHashMultiset[string]("a", "a", "b")
+				old, err := m.Remove("a", 5)
+				return old, err, m
+			},
+			expectedResult: map[string]int64{"b": 1},
+			expectedOld:    2,
+		},
+		"should return error when element is not present": {
+			actualResult: func() (int64, error, Multiset[string]) {
+				m := NewHashMultiset[string]("b")
+				old, err := m.Remove("a", 1)
+				return old, err, m
+			},
+			expectedResult: map[string]int64{"b": 1},
+			expectedError:  errors.New("element a not found in the set"),
+		},
+		"should return error when count is negative": {
+			actualResult: func() (int64, error, Multiset[string]) {
+				m := NewHashMultiset[string]("a")
+				old, err := m.Remove("a", -2)
+				return old, err, m
+			},
+			expectedResult: map[string]int64{"a": 1},
+			expectedError:  errors.New("invalid count -2"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			old, err, m := testCase.actualResult()
+
+			internal.AssertErrorEquals(t, testCase.expectedError, err)
+			assert.Equal(t, testCase.expectedOld, old)
+			assert.Equal(t, testCase.expectedResult, multisetToMap(m))
+		})
+	}
+}
+
+func TestHashMultisetSetCount(t *testing.T) {
+	m := NewHashMultiset[string]("a", "b")
+
+	old, err := m.SetCount("a", 4)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), old)
+
+	old, err = m.SetCount("b", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), old)
+
+	assert.Equal(t, map[string]int64{"a": 4}, multisetToMap[string](m))
+	assert.Equal(t, int64(4), m.Size())
+
+	_, err = m.SetCount("a", -1)
+	internal.AssertErrorEquals(t, errors.New("invalid count -1"), err)
+}
+
+func TestHashMultisetIterator(t *testing.T) {
+	m := NewHashMultiset[int](3, 1, 3, 2, 3)
+
+	res := make([]int, 0)
+
+	it := m.Iterator()
+	for it.HasNext() {
+		v, err := it.Next()
+		require.NoError(t, err)
+		res = append(res, v)
+	}
+
+	sort.Ints(res)
+	assert.Equal(t, []int{1, 2, 3, 3, 3}, res)
+
+	_, err := it.Next()
+	internal.AssertErrorEquals(t, errors.New("iterator is empty"), err)
+
+	es := m.ElementSet()
+	assert.Equal(t, int64(3), es.Size())
+	assert.True(t, es.ContainsAll(1, 2, 3))
+}
+
+func TestHashMultisetOperations(t *testing.T) {
+	a := NewHashMultiset[string]("x", "x", "x", "y", "z")
+	b := NewHashMultiset[string]("x", "y", "y", "w")
+
+	testCases := map[string]struct {
+		actualResult   func() Multiset[string]
+		expectedResult map[string]int64
+	}{
+		"union should keep max count": {
+			actualResult:   func() Multiset[string] { return a.Union(b) },
+			expectedResult: map[string]int64{"x": 3, "y": 2, "z": 1, "w": 1},
+		},
+		"intersection should keep min count": {
+			actualResult:   func() Multiset[string] { return a.Intersection(b) },
+			expectedResult: map[string]int64{"x": 1, "y": 1},
+		},
+		"difference should subtract counts": {
+			actualResult:   func() Multiset[string] { return a.Difference(b) },
+			expectedResult: map[string]int64{"x": 2, "z": 1},
+		},
+		"sum should add counts": {
+			actualResult:   func() Multiset[string] { return a.Sum(b) },
+			expectedResult: map[string]int64{"x": 4, "y": 3, "z": 1, "w": 1},
+		},
+		"copy should keep counts": {
+			actualResult:   func() Multiset[string] { return a.Copy() },
+			expectedResult: map[string]int64{"x": 3, "y": 1, "z": 1},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res := testCase.actualResult()
+
+			assert.IsType(t, &HashMultiset[string]{}, res)
+			assert.Equal(t, testCase.expectedResult, multisetToMap(res))
+		})
+	}
+
+	assert.Equal(t, int64(5), a.Size())
+	assert.Equal(t, int64(4), b.Size())
+}
+
+func TestHashMultisetClear(t *testing.T) {
+	m := NewHashMultiset[int](1, 1, 2)
+	m.Clear()
+
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, int64(0), m.Count(1))
+	assert.False(t, m.Iterator().HasNext())
+}
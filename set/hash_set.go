@@ -115,7 +115,7 @@ func (hs *HashSet[T]) Union(s Set[T]) (Set[T], error) {
 
 	ns.union(hs)
 
-	ns.union(s.(*HashSet[T]))
+	ns.union(s)
 
 	return ns, nil
 }
@@ -217,7 +217,7 @@ func (hs *HashSet[T]) contains(e ...T) bool {
 	return true
 }
 
-func (hs *HashSet[T]) union(b *HashSet[T]) {
+func (hs *HashSet[T]) union(b Set[T]) {
 	it := b.Iterator()
 	for it.HasNext() {
 		v, _ := it.Next()
@@ -0,0 +1,262 @@
+package set
+
+import (
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	"github.com/nsnikhil/go-datastructures/internal"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+)
+
+type linkedNode[T comparable] struct {
+	element T
+	prev    *linkedNode[T]
+	next    *linkedNode[T]
+}
+
+type LinkedHashSet[T comparable] struct {
+	data  gmap.Map[T, *linkedNode[T]]
+	first *linkedNode[T]
+	last  *linkedNode[T]
+}
+
+func NewLinkedHashSet[T comparable](e ...T) *LinkedHashSet[T] {
+	lhs := &LinkedHashSet[T]{data: gmap.NewHashMap[T, *linkedNode[T]]()}
+
+	lhs.insert(e...)
+
+	return lhs
+}
+
+func (lhs *LinkedHashSet[T]) Add(e T) {
+	lhs.insert(e)
+}
+
+func (lhs *LinkedHashSet[T]) AddAll(e ...T) {
+	lhs.insert(e...)
+}
+
+func (lhs *LinkedHashSet[T]) Clear() {
+	lhs.data.Clear()
+	lhs.first = nil
+	lhs.last = nil
+}
+
+func (lhs *LinkedHashSet[T]) Contains(e T) bool {
+	return lhs.data.ContainsKey(e)
+}
+
+func (lhs *LinkedHashSet[T]) ContainsAll(e ...T) bool {
+	for _, k := range e {
+		if !lhs.data.ContainsKey(k) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (lhs *LinkedHashSet[T]) Copy() Set[T] {
+	res := NewLinkedHashSet[T]()
+
+	for curr := lhs.first; curr != nil; curr = curr.next {
+		res.insert(curr.element)
+	}
+
+	return res
+}
+
+func (lhs *LinkedHashSet[T]) IsEmpty() bool {
+	return lhs.data.IsEmpty()
+}
+
+func (lhs *LinkedHashSet[T]) Size() int64 {
+	return lhs.data.Size()
+}
+
+func (lhs *LinkedHashSet[T]) Remove(e T) error {
+	return lhs.remove(false, e)
+}
+
+func (lhs *LinkedHashSet[T]) RemoveAll(e ...T) error {
+	return lhs.remove(true, e...)
+}
+
+func (lhs *LinkedHashSet[T]) RetainAll(e ...T) error {
+	if lhs.IsEmpty() {
+		return emptySetError("LinkedHashSet.RetainAll")
+	}
+
+	tm := make(map[T]bool)
+
+	for _, k := range e {
+		tm[k] = true
+	}
+
+	curr := lhs.first
+	for curr != nil {
+		next := curr.next
+
+		if !tm[curr.element] {
+			lhs.unlink(curr)
+		}
+
+		curr = next
+	}
+
+	return nil
+}
+
+func (lhs *LinkedHashSet[T]) Iterator() iterator.Iterator[T] {
+	return &linkedHashSetIterator[T]{curr: lhs.first}
+}
+
+func (lhs *LinkedHashSet[T]) DescendingIterator() iterator.Iterator[T] {
+	return &linkedHashSetIterator[T]{curr: lhs.last, descending: true}
+}
+
+func (lhs *LinkedHashSet[T]) Union(s Set[T]) (Set[T], error) {
+	ns := lhs.Copy().(*LinkedHashSet[T])
+
+	it := s.Iterator()
+	for it.HasNext() {
+		e, _ := it.Next()
+		ns.insert(e)
+	}
+
+	return ns, nil
+}
+
+func (lhs *LinkedHashSet[T]) Intersection(s Set[T]) (Set[T], error) {
+	ns := NewLinkedHashSet[T]()
+
+	for curr := lhs.first; curr != nil; curr = curr.next {
+		if s.Contains(curr.element) {
+			ns.insert(curr.element)
+		}
+	}
+
+	return ns, nil
+}
+
+func (lhs *LinkedHashSet[T]) First() (T, error) {
+	if lhs.IsEmpty() {
+		return internal.ZeroValueOf[T](), emptySetError("LinkedHashSet.First")
+	}
+
+	return lhs.first.element, nil
+}
+
+func (lhs *LinkedHashSet[T]) Last() (T, error) {
+	if lhs.IsEmpty() {
+		return internal.ZeroValueOf[T](), emptySetError("LinkedHashSet.Last")
+	}
+
+	return lhs.last.element, nil
+}
+
+func (lhs *LinkedHashSet[T]) RemoveFirst() (T, error) {
+	if lhs.IsEmpty() {
+		return internal.ZeroValueOf[T](), emptySetError("LinkedHashSet.RemoveFirst")
+	}
+
+	n := lhs.first
+	lhs.unlink(n)
+
+	return n.element, nil
+}
+
+func (lhs *LinkedHashSet[T]) RemoveLast() (T, error) {
+	if lhs.IsEmpty() {
+		return internal.ZeroValueOf[T](), emptySetError("LinkedHashSet.RemoveLast")
+	}
+
+	n := lhs.last
+	lhs.unlink(n)
+
+	return n.element, nil
+}
+
+type linkedHashSetIterator[T comparable] struct {
+	curr       *linkedNode[T]
+	descending bool
+}
+
+func (lhi *linkedHashSetIterator[T]) HasNext() bool {
+	return lhi.curr != nil
+}
+
+func (lhi *linkedHashSetIterator[T]) Next() (T, error) {
+	if lhi.curr == nil {
+		return internal.ZeroValueOf[T](), emptyIteratorError("linkedHashSetIterator.Next")
+	}
+
+	e := lhi.curr.element
+
+	if lhi.descending {
+		lhi.curr = lhi.curr.prev
+	} else {
+		lhi.curr = lhi.curr.next
+	}
+
+	return e, nil
+}
+
+func (lhs *LinkedHashSet[T]) insert(e ...T) {
+	for _, k := range e {
+		if lhs.data.ContainsKey(k) {
+			continue
+		}
+
+		n := &linkedNode[T]{element: k, prev: lhs.last}
+
+		if lhs.last == nil {
+			lhs.first = n
+		} else {
+			lhs.last.next = n
+		}
+
+		lhs.last = n
+
+		lhs.data.Put(k, n)
+	}
+}
+
+func (lhs *LinkedHashSet[T]) remove(ignore bool, e ...T) error {
+	if lhs.IsEmpty() {
+		return emptySetError("LinkedHashSet.remove")
+	}
+
+	if len(e) == 0 {
+		return emptyArgsListError("LinkedHashSet.remove")
+	}
+
+	for _, k := range e {
+		n, err := lhs.data.Get(k)
+		if err != nil {
+			if ignore {
+				continue
+			}
+
+			return elementNotFoundError(k, "LinkedHashSet.remove")
+		}
+
+		lhs.unlink(n)
+	}
+
+	return nil
+}
+
+func (lhs *LinkedHashSet[T]) unlink(n *linkedNode[T]) {
+	if n.prev == nil {
+		lhs.first = n.next
+	} else {
+		n.prev.next = n.next
+	}
+
+	if n.next == nil {
+		lhs.last = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+
+	_, _ = lhs.data.Remove(n.element)
+}
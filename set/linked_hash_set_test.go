@@ -0,0 +1,174 @@
+package set
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateNewLinkedHashSet(t *testing.T) {
+	s := NewLinkedHashSet[int](5, 3, 5, 1, 3)
+
+	var _ Set[int] = s
+
+	assert.Equal(t, int64(3), s.Size())
+	assert.Equal(t, []int{5, 3, 1}, toSlice[int](s))
+	assert.True(t, NewLinkedHashSet[int]().IsEmpty())
+}
+
+func TestLinkedHashSetAdd(t *testing.T) {
+	s := NewLinkedHashSet[string]()
+
+	s.Add("c")
+	s.AddAll("a", "c", "b")
+	s.Add("a")
+
+	assert.Equal(t, []string{"c", "a", "b"}, toSlice[string](s))
+	assert.True(t, s.Contains("b"))
+	assert.True(t, s.ContainsAll("a", "b", "c"))
+	assert.False(t, s.ContainsAll("a", "d"))
+}
+
+func TestLinkedHashSetRemove(t *testing.T) {
+	testCases := map[string]struct {
+		actualResult   func() (Set[int], error)
+		expectedResult []int
+		expectedError  error
+	}{
+		"should remove element from middle": {
+			actualResult: func() (Set[int], error) {
+				s := NewLinkedHashSet[int](1, 2, 3)
+				return s, s.Remove(2)
+			},
+			expectedResult: []int{1, 3},
+		},
+		"should remove first and last element": {
+			actualResult: func() (Set[int], error) {
+				s := NewLinkedHashSet[int](1, 2, 3)
+				return s, s.RemoveAll(1, 3)
+			},
+			expectedResult: []int{2},
+		},
+		"should ignore missing elements in remove all": {
+			actualResult: func() (Set[int], error) {
+				s := NewLinkedHashSet[int](1, 2, 3)
+				return s, s.RemoveAll(4, 2)
+			},
+			expectedResult: []int{1, 3},
+		},
+		"should return error when element is missing": {
+			actualResult: func() (Set[int], error) {
+				s := NewLinkedHashSet[int](1, 2, 3)
+				return s, s.Remove(4)
+			},
+			expectedResult: []int{1, 2, 3},
+			expectedError:  errors.New("element 4 not found in the set"),
+		},
+		"should return error when set is empty": {
+			actualResult: func() (Set[int], error) {
+				s := NewLinkedHashSet[int]()
+				return s, s.Remove(4)
+			},
+			expectedResult: []int{},
+			expectedError:  errors.New("set is empty"),
+		},
+		"should return error when args are empty": {
+			actualResult: func() (Set[int], error) {
+				s := NewLinkedHashSet[int](1)
+				return s, s.RemoveAll()
+			},
+			expectedResult: []int{1},
+			expectedError:  errors.New("argument list is empty"),
+		},
+		"should retain only given elements in order": {
+			actualResult: func() (Set[int], error) {
+				s := NewLinkedHashSet[int](1, 2, 3, 4, 5)
+				return s, s.RetainAll(5, 2, 4)
+			},
+			expectedResult: []int{2, 4, 5},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s, err := testCase.actualResult()
+
+			internal.AssertErrorEquals(t, testCase.expectedError, err)
+			assert.Equal(t, testCase.expectedResult, toSlice(s))
+		})
+	}
+}
+
+func TestLinkedHashSetIterator(t *testing.T) {
+	s := NewLinkedHashSet[int](3, 1, 2)
+
+	it := s.DescendingIterator()
+
+	res := make([]int, 0)
+	for it.HasNext() {
+		v, _ := it.Next()
+		res = append(res, v)
+	}
+
+	assert.Equal(t, []int{2, 1, 3}, res)
+
+	_, err := it.Next()
+	internal.AssertErrorEquals(t, errors.New("iterator is empty"), err)
+}
+
+func TestLinkedHashSetUnionAndIntersection(t *testing.T) {
+	a := NewLinkedHashSet[int](4, 1, 3)
+	b := NewLinkedHashSet[int](5, 3, 2, 4)
+
+	u, err := a.Union(b)
+	require.NoError(t, err)
+	assert.Equal(t, []int{4, 1, 3, 5, 2}, toSlice(u))
+
+	i, err := a.Intersection(b)
+	require.NoError(t, err)
+	assert.Equal(t, []int{4, 3}, toSlice(i))
+
+	assert.Equal(t, []int{4, 1, 3, 5, 2}, toSlice(Union[int](a, b)))
+	assert.Equal(t, []int{1}, toSlice(Difference[int](a, b)))
+	assert.Equal(t, []int{1, 5, 2}, toSlice(SymmetricDifference[int](a, b)))
+
+	h, err := a.Union(NewHashSet[int](9))
+	require.NoError(t, err)
+	assert.Equal(t, []int{4, 1, 3, 9}, toSlice(h))
+}
+
+func TestLinkedHashSetFirstAndLast(t *testing.T) {
+	s := NewLinkedHashSet[int]()
+
+	_, err := s.First()
+	internal.AssertErrorEquals(t, errors.New("set is empty"), err)
+
+	_, err = s.RemoveFirst()
+	internal.AssertErrorEquals(t, errors.New("set is empty"), err)
+
+	s.AddAll(7, 8, 9)
+
+	f, err := s.First()
+	require.NoError(t, err)
+	assert.Equal(t, 7, f)
+
+	l, err := s.Last()
+	require.NoError(t, err)
+	assert.Equal(t, 9, l)
+
+	f, err = s.RemoveFirst()
+	require.NoError(t, err)
+	assert.Equal(t, 7, f)
+
+	l, err = s.RemoveLast()
+	require.NoError(t, err)
+	assert.Equal(t, 9, l)
+
+	assert.Equal(t, []int{8}, toSlice[int](s))
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.False(t, s.Iterator().HasNext())
+}
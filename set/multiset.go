@@ -0,0 +1,40 @@
+package set
+
+import (
+	"github.com/nsnikhil/go-datastructures/functions/iterator"
+	gmap "github.com/nsnikhil/go-datastructures/map"
+)
+
+type Multiset[T comparable] interface {
+	Add(e T, n int64) error
+
+	Count(e T) int64
+
+	Remove(e T, n int64) (int64, error)
+
+	SetCount(e T, n int64) (int64, error)
+
+	Contains(e T) bool
+
+	Clear()
+
+	Copy() Multiset[T]
+
+	IsEmpty() bool
+
+	Size() int64
+
+	ElementSet() Set[T]
+
+	EntryIterator() iterator.Iterator[*gmap.Pair[T, int64]]
+
+	Iterator() iterator.Iterator[T]
+
+	Union(m Multiset[T]) Multiset[T]
+
+	Intersection(m Multiset[T]) Multiset[T]
+
+	Difference(m Multiset[T]) Multiset[T]
+
+	Sum(m Multiset[T]) Multiset[T]
+}
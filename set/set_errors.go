@@ -37,3 +37,19 @@ var elementNotFoundError = func(key interface{}, operation erx.Operation) *erx.E
 		fmt.Errorf("element %v not found in the set", key),
 	)
 }
+
+var invalidCountError = func(count int64, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("invalidCountError"),
+		operation,
+		fmt.Errorf("invalid count %d", count),
+	)
+}
+
+var setTooLargeError = func(size int64, operation erx.Operation) *erx.Erx {
+	return erx.WithArgs(
+		erx.Kind("setTooLargeError"),
+		operation,
+		fmt.Errorf("set of size %d is too large", size),
+	)
+}
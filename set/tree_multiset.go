@@ -0,0 +1,46 @@
+package set
+
+import (
+	"github.com/nsnikhil/go-datastructures/functions/comparator"
+	"github.com/nsnikhil/go-datastructures/internal"
+)
+
+type TreeMultiset[T comparable] struct {
+	*multiset[T]
+}
+
+func NewTreeMultiset[T comparable](c comparator.Comparator[T], e ...T) *TreeMultiset[T] {
+	tm := &TreeMultiset[T]{multiset: newMultiset[T](c)}
+
+	tm.insert(e...)
+
+	return tm
+}
+
+func (tm *TreeMultiset[T]) First() (T, error) {
+	if tm.IsEmpty() {
+		return internal.ZeroValueOf[T](), emptySetError("TreeMultiset.First")
+	}
+
+	e, err := tm.order.InOrderIterator().Next()
+	if err != nil {
+		return internal.ZeroValueOf[T](), err
+	}
+
+	return e, nil
+}
+
+func (tm *TreeMultiset[T]) Last() (T, error) {
+	if tm.IsEmpty() {
+		return internal.ZeroValueOf[T](), emptySetError("TreeMultiset.Last")
+	}
+
+	var res T
+
+	it := tm.order.InOrderIterator()
+	for it.HasNext() {
+		res, _ = it.Next()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package set
+
+import (
+	"errors"
+	"github.com/nsnikhil/go-datastructures/functions/comparator"
+	"github.com/nsnikhil/go-datastructures/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTreeMultisetIteratorIsSorted(t *testing.T) {
+	m := NewTreeMultiset[int](comparator.NewIntegerComparator(), 5, 1, 3, 5, 2, 1)
+
+	res := make([]int, 0)
+
+	it := m.Iterator()
+	for it.HasNext() {
+		v, _ := it.Next()
+		res = append(res, v)
+	}
+
+	assert.Equal(t, []int{1, 1, 2, 3, 5, 5}, res)
+}
+
+func TestTreeMultisetKeepsOrderAfterRemove(t *testing.T) {
+	m := NewTreeMultiset[int](comparator.NewIntegerComparator(), 4, 2, 6, 1, 3, 5, 7)
+
+	_, err := m.Remove(4, 1)
+	require.NoError(t, err)
+
+	_, err = m.SetCount(6, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Add(8, 2))
+
+	keys := make([]int, 0)
+
+	it := m.EntryIterator()
+	for it.HasNext() {
+		p, _ := it.Next()
+		keys = append(keys, p.First())
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 5, 7, 8}, keys)
+	assert.Equal(t, int64(7), m.Size())
+}
+
+func TestTreeMultisetFirstAndLast(t *testing.T) {
+	m := NewTreeMultiset[string](comparator.NewStringComparator())
+
+	_, err := m.First()
+	internal.AssertErrorEquals(t, errors.New("set is empty"), err)
+
+	_, err = m.Last()
+	internal.AssertErrorEquals(t, errors.New("set is empty"), err)
+
+	require.NoError(t, m.Add("m", 1))
+	require.NoError(t, m.Add("c", 2))
+	require.NoError(t, m.Add("x", 1))
+
+	f, err := m.First()
+	require.NoError(t, err)
+	assert.Equal(t, "c", f)
+
+	l, err := m.Last()
+	require.NoError(t, err)
+	assert.Equal(t, "x", l)
+}
+
+func TestTreeMultisetOperationsStaySorted(t *testing.T) {
+	a := NewTreeMultiset[int](comparator.NewIntegerComparator(), 3, 3, 1)
+	b := NewHashMultiset[int](2, 3, 4)
+
+	res := a.Sum(b)
+	require.IsType(t, &TreeMultiset[int]{}, res)
+
+	values := make([]int, 0)
+
+	it := res.Iterator()
+	for it.HasNext() {
+		v, _ := it.Next()
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 3, 3, 4}, values)
+	assert.Equal(t, map[int]int64{3: 1}, multisetToMap(a.Intersection(b)))
+}